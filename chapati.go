@@ -0,0 +1,159 @@
+// Package chapati generates curried functions from Go source code.
+package chapati
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/infrastructure"
+	"github.com/syuparn/chapati/interface/checker"
+	"github.com/syuparn/chapati/interface/controller"
+	"github.com/syuparn/chapati/interface/presenter"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// Options is a setting of code generation.
+type Options struct {
+	// CurriedFuncPrefix is prepended to names of curried functions (default: "Curried").
+	CurriedFuncPrefix string
+	// FuncNames limits functions to be curried. All functions are curried if empty.
+	FuncNames []string
+	// PackagePath is the import path of the source package.
+	// Package name is used if empty.
+	PackagePath string
+	// ConfigFile is the configuration file (chapati.yaml) applied to the source.
+	ConfigFile string
+	// SearchConfig enables to search the configuration file from the directory of filename
+	// to its ancestors if ConfigFile is empty.
+	SearchConfig bool
+	// SkipTypeCheck disables to type-check generated code with the source package.
+	SkipTypeCheck bool
+}
+
+func (o Options) controllerOptions() []controller.Option {
+	opts := []controller.Option{}
+
+	if o.CurriedFuncPrefix != "" {
+		opts = append(opts, controller.WithCurriedFuncPrefix(o.CurriedFuncPrefix))
+	}
+
+	if len(o.FuncNames) > 0 {
+		opts = append(opts, controller.WithFuncNames(o.FuncNames...))
+	}
+
+	if o.PackagePath != "" {
+		opts = append(opts, controller.WithPackagePath(o.PackagePath))
+	}
+
+	if o.ConfigFile != "" {
+		opts = append(opts, controller.WithConfigFile(o.ConfigFile))
+	}
+
+	if o.SearchConfig {
+		opts = append(opts, controller.WithConfigSearch())
+	}
+
+	return opts
+}
+
+// Generate generates a source file of curried functions in src.
+// If src is nil, source code is read from filename.
+func Generate(src []byte, filename string, opts Options) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := generate(src, filename, opts, presenter.NewCurryFunctionPresenter(&buf)); err != nil {
+		return nil, err
+	}
+
+	if !opts.SkipTypeCheck {
+		if err := typeCheck(src, filename, checker.File{Name: outputFileOf(filename), Src: buf.Bytes()}); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GenerateTests generates a test file to check each curried function in src
+// is equivalent to the original one.
+// If src is nil, source code is read from filename.
+func GenerateTests(src []byte, filename string, opts Options) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := generate(src, filename, opts, presenter.NewCurryFunctionTestPresenter(&buf)); err != nil {
+		return nil, err
+	}
+
+	if !opts.SkipTypeCheck {
+		// NOTE: tests refer to curried functions
+		code, err := Generate(src, filename, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		outputFile := outputFileOf(filename)
+		err = typeCheck(src, filename,
+			checker.File{Name: outputFile, Src: code},
+			checker.File{Name: strings.TrimSuffix(outputFile, ".go") + "_test.go", Src: buf.Bytes()},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GenerateCode generates curried functions in src as jen.Code,
+// which can be embedded into a jen.File.
+// Types declared in the source package are qualified by opts.PackagePath
+// (or the package name if it is empty).
+// If src is nil, source code is read from filename.
+func GenerateCode(src []byte, filename string, opts Options) (jen.Code, error) {
+	var code jen.Code
+	receive := func(c jen.Code) { code = c }
+
+	if err := generate(src, filename, opts, presenter.NewCurryFunctionCodePresenter(receive)); err != nil {
+		return nil, err
+	}
+
+	return code, nil
+}
+
+func generate(
+	src []byte,
+	filename string,
+	opts Options,
+	out usecase.CurryFunctionOutputPort,
+) error {
+	inputPort := usecase.NewCurryFunctionInputPort(out, infrastructure.NewCurryService())
+	c := controller.NewCurryFunctionController(inputPort, opts.controllerOptions()...)
+
+	var r io.Reader
+	if src != nil {
+		r = bytes.NewReader(src)
+	}
+
+	if err := c.HandleSource(filename, r); err != nil {
+		return xerrors.Errorf("failed to generate curried functions: %w", err)
+	}
+
+	return nil
+}
+
+func typeCheck(src []byte, filename string, generated ...checker.File) error {
+	if err := checker.Check(filename, src, generated...); err != nil {
+		return xerrors.Errorf("failed to type-check generated code: %w", err)
+	}
+	return nil
+}
+
+// outputFileOf returns the default output file name of the source.
+func outputFileOf(filename string) string {
+	return filepath.Join(filepath.Dir(filename), "generate.curried."+filepath.Base(filename))
+}
@@ -0,0 +1,261 @@
+package chapati
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/lithammer/dedent"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		opts     Options
+		expected string
+	}{
+		{
+			"default options",
+			`
+			package mypackage
+
+			func add(i1 int, i2 int) int {
+				return i1 + i2
+			}
+
+			func neg(i int) int {
+				return -i
+			}
+
+			func concat(s1, s2, s3 string) string {
+				return s1 + s2 + s3
+			}
+			`,
+			Options{},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedAdd is the curried form of add.
+			func CurriedAdd(i1 int) func(int) int {
+				return func(i2 int) int {
+					return add(i1, i2)
+				}
+			}
+
+			// CurriedConcat is the curried form of concat.
+			func CurriedConcat(s1 string) func(string) func(string) string {
+				return func(s2 string) func(string) string {
+					return func(s3 string) string {
+						return concat(s1, s2, s3)
+					}
+				}
+			}
+			`,
+		},
+		{
+			"with options",
+			`
+			package mypackage
+
+			func add(i1 int, i2 int) int {
+				return i1 + i2
+			}
+
+			func sub(i1 int, i2 int) int {
+				return i1 - i2
+			}
+			`,
+			Options{
+				CurriedFuncPrefix: "C",
+				FuncNames:         []string{"sub"},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CSub is the curried form of sub.
+			func CSub(i1 int) func(int) int {
+				return func(i2 int) int {
+					return sub(i1, i2)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := []byte(dedent.Dedent(tt.src))
+
+			actual, err := Generate(src, "mypackage.go", tt.opts)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if string(actual) != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, string(actual))
+			}
+		})
+	}
+}
+
+func TestGenerateFromFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		fileName     string
+		expectedFile string
+	}{
+		{
+			"example",
+			"example/example.go",
+			"example/generate.curried.example.go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := Generate(nil, tt.fileName, Options{})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			expected, err := os.ReadFile(tt.expectedFile)
+			if err != nil {
+				t.Fatalf("failed to read expected file: %v", err)
+			}
+
+			if string(actual) != string(expected) {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestGenerateTests(t *testing.T) {
+	src := `
+	package mypackage
+
+	func add(i1 int, i2 int) int {
+		return i1 + i2
+	}
+	`
+	expected := `
+	// Code generated by chapati; DO NOT EDIT.
+
+	package mypackage
+
+	import (
+		"reflect"
+		"testing"
+		"testing/quick"
+	)
+
+	func TestCurriedAdd(t *testing.T) {
+		f := func(i1 int, i2 int) bool {
+			actual0 := CurriedAdd(i1)(i2)
+			expected0 := add(i1, i2)
+			return reflect.DeepEqual(actual0, expected0)
+		}
+		if err := quick.Check(f, nil); err != nil {
+			t.Error(err)
+		}
+	}
+	`
+
+	actual, err := GenerateTests([]byte(dedent.Dedent(src)), "mypackage.go", Options{})
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	if string(actual) != strings.TrimPrefix(dedent.Dedent(expected), "\n") {
+		t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", dedent.Dedent(expected), string(actual))
+	}
+}
+
+func TestGenerateCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		opts     Options
+		expected string
+	}{
+		{
+			"embed into jen.File",
+			`
+			package mypackage
+
+			type Point struct {
+				X, Y int
+			}
+
+			func move(p Point, d int) Point {
+				return Point{X: p.X + d, Y: p.Y + d}
+			}
+			`,
+			Options{
+				PackagePath: "example.com/mypackage",
+			},
+			`
+			package mypackage
+
+			// CurriedMove is the curried form of move.
+			func CurriedMove(p Point) func(int) Point {
+				return func(d int) Point {
+					return move(p, d)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := []byte(dedent.Dedent(tt.src))
+
+			code, err := GenerateCode(src, "mypackage.go", tt.opts)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			f := jen.NewFilePathName("example.com/mypackage", "mypackage")
+			f.Add(code)
+
+			actual := fmt.Sprintf("%#v", f)
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestGenerateFailed(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{
+			"parse error",
+			"I am not go",
+		},
+		{
+			"no functions to be curried",
+			"package mypackage\n\nfunc neg(i int) int { return -i }\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Generate([]byte(tt.src), "mypackage.go", Options{}); err == nil {
+				t.Fatalf("error must not be nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/di"
+	"github.com/syuparn/chapati/interface/controller"
+)
+
+func newCheckCommand() *command {
+	cmd := newCommand(
+		"check",
+		"check generated files are up to date with the input files",
+		"[options] <inputfile>...",
+	)
+
+	outputFile := cmd.flags.String("o", "", "generated file name, only available with one input file (default: 'generate.curried.{input file name}.go')")
+
+	configFile := addConfigFlag(cmd.flags)
+	deep := addDeepFlag(cmd.flags)
+	renderFlags := addCodeFlags(cmd.flags)
+
+	cmd.run = func(args []string) error {
+		if len(args) == 0 {
+			return xerrors.Errorf("input file names must not be empty")
+		}
+
+		if *outputFile != "" && len(args) > 1 {
+			return xerrors.Errorf("-o cannot be used with multiple input files")
+		}
+
+		opts, err := renderFlags.options()
+		if err != nil {
+			return err
+		}
+		opts = append(opts, configOption(*configFile), deepOption(*deep))
+
+		outdated := 0
+		for _, in := range args {
+			if *deep && controller.IsSpecFile(in) {
+				return xerrors.Errorf("deep cannot be used with specs")
+			}
+
+			out := *outputFile
+			if out == "" {
+				out, err = outputFileOf(in, *configFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			ok, err := isUpToDate(in, out, opts...)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				fmt.Fprintf(os.Stderr, "%s is out of date with %s\n", out, in)
+				outdated++
+			}
+		}
+
+		if outdated > 0 {
+			return xerrors.Errorf("%d generated file(s) are out of date (run 'chapati gen')", outdated)
+		}
+
+		return nil
+	}
+
+	return cmd
+}
+
+func isUpToDate(in, out string, opts ...di.Option) (bool, error) {
+	if in == StdioFileName || out == StdioFileName {
+		return false, xerrors.Errorf("stdin and stdout cannot be checked")
+	}
+
+	expected, err := generate(in, nil, opts...)
+	if err != nil {
+		return false, err
+	}
+
+	actual, err := os.ReadFile(out)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, xerrors.Errorf("failed to read generated file %s: %w", out, err)
+	}
+
+	return bytes.Equal(expected, actual), nil
+}
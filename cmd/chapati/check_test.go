@@ -0,0 +1,68 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	src := "package p\n\nfunc add(i1, i2 int) int {\n\treturn i1 + i2\n}\n"
+	generated := `// Code generated by chapati; DO NOT EDIT.
+
+package p
+
+// CurriedAdd is the curried form of add.
+func CurriedAdd(i1 int) func(int) int {
+	return func(i2 int) int {
+		return add(i1, i2)
+	}
+}
+`
+
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected bool
+	}{
+		{
+			"up to date",
+			map[string]string{
+				"p.go":                  src,
+				"generate.curried.p.go": generated,
+			},
+			true,
+		},
+		{
+			"out of date",
+			map[string]string{
+				"p.go":                  src,
+				"generate.curried.p.go": "// Code generated by chapati; DO NOT EDIT.\n\npackage p\n",
+			},
+			false,
+		},
+		{
+			"not generated yet",
+			map[string]string{
+				"p.go": src,
+			},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			in := filepath.Join(dir, "p.go")
+			actual, err := isUpToDate(in, defaultOutputFile(in))
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+func newCleanCommand() *command {
+	cmd := newCommand(
+		"clean",
+		"remove files generated by chapati",
+		"[options] [patterns...]\n\n"+
+			"patterns are directories ('dir/...' means all sub directories) or file globs (default: '.')",
+	)
+
+	dryRun := cmd.flags.Bool("n", false, "print files to be removed without removing them")
+
+	cmd.run = func(args []string) error {
+		if len(args) == 0 {
+			args = []string{"."}
+		}
+
+		files, err := findGeneratedFiles(args)
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			fmt.Println(file)
+
+			if *dryRun {
+				continue
+			}
+
+			if err := os.Remove(file); err != nil {
+				return xerrors.Errorf("failed to remove %s: %w", file, err)
+			}
+		}
+
+		return nil
+	}
+
+	return cmd
+}
+
+// findGeneratedFiles returns files generated by chapati matched with the patterns.
+func findGeneratedFiles(patterns []string) ([]string, error) {
+	candidates := []string{}
+
+	for _, pattern := range patterns {
+		matched, err := matchPattern(pattern)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to search %s: %w", pattern, err)
+		}
+		candidates = append(candidates, matched...)
+	}
+
+	files := []string{}
+	found := map[string]bool{}
+
+	for _, file := range candidates {
+		if found[file] || !isGeneratedFileName(file) {
+			continue
+		}
+
+		generated, err := isGeneratedFile(file)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read %s: %w", file, err)
+		}
+
+		if generated {
+			files = append(files, file)
+			found[file] = true
+		}
+	}
+
+	return files, nil
+}
+
+func matchPattern(pattern string) ([]string, error) {
+	if strings.HasSuffix(pattern, "/...") {
+		return walkFiles(strings.TrimSuffix(pattern, "/..."))
+	}
+
+	info, err := os.Stat(pattern)
+	if err == nil && info.IsDir() {
+		return filepath.Glob(filepath.Join(pattern, "*"))
+	}
+
+	return filepath.Glob(pattern)
+}
+
+func walkFiles(root string) ([]string, error) {
+	files := []string{}
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if path != root && isIgnoredDir(info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		files = append(files, path)
+		return nil
+	})
+
+	return files, err
+}
+
+// isIgnoredDir reports whether the directory is ignored by go tool in "./..." patterns.
+func isIgnoredDir(name string) bool {
+	switch name {
+	case "vendor", "testdata":
+		return true
+	}
+	return strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
+
+func isGeneratedFileName(file string) bool {
+	name := filepath.Base(file)
+	return strings.HasPrefix(name, DefaultOutputFilePrefix) && strings.HasSuffix(name, ".go")
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFindGeneratedFiles(t *testing.T) {
+	generated := "// Code generated by chapati; DO NOT EDIT.\n\npackage p\n"
+	handwritten := "package p\n"
+
+	tests := []struct {
+		name     string
+		files    map[string]string
+		patterns []string
+		expected []string
+	}{
+		{
+			"directory",
+			map[string]string{
+				"a.go":                        handwritten,
+				"generate.curried.a.go":       generated,
+				"generate.curried.b.go":       handwritten,
+				"sub/generate.curried.c.go":   generated,
+				"generate.curried.a_test.txt": generated,
+			},
+			[]string{"."},
+			[]string{
+				"generate.curried.a.go",
+			},
+		},
+		{
+			"recursive",
+			map[string]string{
+				"generate.curried.a.go":         generated,
+				"sub/generate.curried.c.go":     generated,
+				"sub/sub/generate.curried.d.go": generated,
+				"sub/sub/d.go":                  handwritten,
+			},
+			[]string{"./..."},
+			[]string{
+				"generate.curried.a.go",
+				"sub/generate.curried.c.go",
+				"sub/sub/generate.curried.d.go",
+			},
+		},
+		{
+			"recursive skips directories ignored by go",
+			map[string]string{
+				"generate.curried.a.go":                    generated,
+				".git/generate.curried.b.go":               generated,
+				"vendor/example.com/generate.curried.c.go": generated,
+				"testdata/generate.curried.d.go":           generated,
+				"_sub/generate.curried.e.go":               generated,
+				".sub/generate.curried.f.go":               generated,
+				"sub/testdata/generate.curried.g.go":       generated,
+				"sub/generate.curried.h.go":                generated,
+			},
+			[]string{"./..."},
+			[]string{
+				"generate.curried.a.go",
+				"sub/generate.curried.h.go",
+			},
+		},
+		{
+			"ignored directory specified explicitly",
+			map[string]string{
+				"testdata/generate.curried.d.go":     generated,
+				"testdata/sub/generate.curried.e.go": generated,
+			},
+			[]string{"testdata/..."},
+			[]string{
+				"testdata/generate.curried.d.go",
+				"testdata/sub/generate.curried.e.go",
+			},
+		},
+		{
+			"glob and duplicated patterns",
+			map[string]string{
+				"generate.curried.a.go":     generated,
+				"sub/generate.curried.c.go": generated,
+			},
+			[]string{"sub/*.go", "sub"},
+			[]string{
+				"sub/generate.curried.c.go",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			patterns := make([]string, len(tt.patterns))
+			for i, p := range tt.patterns {
+				patterns[i] = filepath.Join(dir, p)
+				if filepath.Base(p) == "..." {
+					patterns[i] = filepath.Join(dir, filepath.Dir(p)) + "/..."
+				}
+			}
+
+			actual, err := findGeneratedFiles(patterns)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			expected := make([]string, len(tt.expected))
+			for i, f := range tt.expected {
+				expected[i] = filepath.Join(dir, f)
+			}
+
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("wrong value: expected %#v, got %#v", expected, actual)
+			}
+		})
+	}
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/xerrors"
+)
+
+// command is a subcommand of chapati.
+type command struct {
+	name     string
+	synopsis string
+	flags    *flag.FlagSet
+	// run executes the command with arguments left after parsing flags
+	run func(args []string) error
+}
+
+func newCommand(name, synopsis, usage string) *command {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: chapati %s %s\n\n%s\n\n", name, usage, synopsis)
+		fs.PrintDefaults()
+	}
+
+	return &command{
+		name:     name,
+		synopsis: synopsis,
+		flags:    fs,
+	}
+}
+
+// Execute parses flags and runs the command.
+func (c *command) Execute(args []string) error {
+	if err := c.flags.Parse(args); err != nil {
+		return err
+	}
+
+	return c.run(c.flags.Args())
+}
+
+func commands() []*command {
+	return []*command{
+		newGenCommand(),
+		newCheckCommand(),
+		newListCommand(),
+		newExplainCommand(),
+		newCleanCommand(),
+	}
+}
+
+func run(args []string) error {
+	cmds := commands()
+
+	if len(args) == 0 {
+		usage(os.Stderr, cmds)
+		return xerrors.Errorf("command must be specified")
+	}
+
+	if args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		usage(os.Stdout, cmds)
+		return nil
+	}
+
+	for _, cmd := range cmds {
+		if cmd.name == args[0] {
+			return cmd.Execute(args[1:])
+		}
+	}
+
+	// NOTE: for backward compatibility, arguments without command are handled by gen
+	return cmds[0].Execute(args)
+}
+
+func usage(w io.Writer, cmds []*command) {
+	fmt.Fprintf(w, "usage: chapati <command> [options] [arguments]\n\n")
+	fmt.Fprintf(w, "commands:\n")
+	for _, cmd := range cmds {
+		fmt.Fprintf(w, "  %-7s %s\n", cmd.name, cmd.synopsis)
+	}
+	fmt.Fprintf(w, "\nRun 'chapati <command> -h' for details of each command.\n")
+}
@@ -0,0 +1,22 @@
+package main
+
+// DefaultOutputFilePrefix is added to output file name.
+const DefaultOutputFilePrefix = "generate.curried."
+
+// StdioFileName means stdin as the input file or stdout as the output file.
+const StdioFileName = "-"
+
+// stdinFileName is a dummy file name of the source code read from stdin.
+const stdinFileName = "stdin.go"
+
+// output formats of gen command
+const (
+	formatGo   = "go"
+	formatJSON = "json"
+)
+
+// styles of generated Go code
+const (
+	styleClosure = "closure"
+	styleStruct  = "struct"
+)
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/di"
+	"github.com/syuparn/chapati/interface/checker"
+	"github.com/syuparn/chapati/interface/controller"
+	"github.com/syuparn/chapati/interface/presenter"
+)
+
+func newGenCommand() *command {
+	cmd := newCommand(
+		"gen",
+		"generate curried functions of the input file",
+		"[options] <inputfile>\n\n(use '-' as inputfile to read from stdin)",
+	)
+
+	outputFile := cmd.flags.String("o", "", "output file name, or '-' for stdout (default: 'generate.curried.{input file name}.go', or stdout if input is stdin)")
+	force := cmd.flags.Bool("force", false, "overwrite output file even if it was not generated by chapati or is outside the module root")
+
+	tests := cmd.flags.Bool("tests", false, "also generate tests to check curried functions are equivalent to the originals ('{output file name}_test.go')")
+	bench := cmd.flags.Bool("bench", false, "also generate benchmarks of curried functions and the originals ('{output file name}_bench_test.go')")
+	typeCheck := cmd.flags.Bool("typecheck", true, "type-check generated code with the source package before writing it")
+	format := cmd.flags.String("format", formatGo, "output format ('go' or 'json', json outputs signatures of curried functions to stdout by default)")
+	configFile := addConfigFlag(cmd.flags)
+	deep := addDeepFlag(cmd.flags)
+	renderFlags := addCodeFlags(cmd.flags)
+
+	cmd.run = func(args []string) error {
+		if len(args) == 0 || args[0] == "" {
+			return xerrors.Errorf("input file name must not be empty")
+		}
+
+		in := args[0]
+
+		if *format == formatJSON {
+			if !renderFlags.isDefault() {
+				return xerrors.Errorf("template, style, memoize, must, result, async and hook cannot be used with json format")
+			}
+			if *tests || *bench {
+				return xerrors.Errorf("tests and benchmarks cannot be generated with json format")
+			}
+			// NOTE: json cannot express calls of returned functions
+			if *deep {
+				return xerrors.Errorf("deep cannot be used with json format")
+			}
+			return genJSON(in, *outputFile, *force, *configFile)
+		}
+		if *format != formatGo {
+			return xerrors.Errorf("unknown format %q (must be %q or %q)", *format, formatGo, formatJSON)
+		}
+		// NOTE: returned function types in specs are not structured
+		if *deep && controller.IsSpecFile(in) {
+			return xerrors.Errorf("deep cannot be used with specs")
+		}
+
+		out := *outputFile
+		if out == "" {
+			var err error
+			out, err = outputFileOf(in, *configFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		if (*tests || *bench) && out == StdioFileName {
+			return xerrors.Errorf("tests and benchmarks cannot be generated with stdout output")
+		}
+
+		src, err := readStdin(in)
+		if err != nil {
+			return err
+		}
+
+		opts, err := renderFlags.options()
+		if err != nil {
+			return err
+		}
+
+		code, err := generate(in, src, append(opts, configOption(*configFile), deepOption(*deep))...)
+		if err != nil {
+			return err
+		}
+		outputs := []checker.File{{Name: checkedFileName(in, out), Src: code}}
+
+		// NOTE: tests and benchmarks call curried functions in the style
+		presenters, err := stylePresentersOf(*renderFlags.style)
+		if err != nil {
+			return err
+		}
+
+		if *tests {
+			testCode, err := generate(in, src, di.WithPresenter(presenters.tests), configOption(*configFile), deepOption(*deep))
+			if err != nil {
+				return err
+			}
+			outputs = append(outputs, checker.File{Name: testFileOf(out), Src: testCode})
+		}
+
+		if *bench {
+			benchCode, err := generate(in, src, di.WithPresenter(presenters.benchmarks), configOption(*configFile), deepOption(*deep))
+			if err != nil {
+				return err
+			}
+			outputs = append(outputs, checker.File{Name: benchFileOf(out), Src: benchCode})
+		}
+
+		// NOTE: nothing is written if any generated code does not compile
+		// (specs cannot be type-checked because the original functions may not exist in Go source code)
+		if *typeCheck && !controller.IsSpecFile(in) {
+			if err := checker.Check(sourceFileName(in), src, outputs...); err != nil {
+				return err
+			}
+		}
+
+		if err := writeOutput(in, out, code, *force); err != nil {
+			return err
+		}
+
+		// NOTE: tests and benchmarks are written next to the generated code
+		for _, output := range outputs[1:] {
+			if err := writeOutput(in, output.Name, output.Src, *force); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return cmd
+}
+
+// genJSON writes signatures of curried functions as JSON.
+func genJSON(in, out string, force bool, configFile string) error {
+	if out == "" {
+		out = StdioFileName
+	}
+
+	b, err := generate(in, nil,
+		di.WithPresenter(presenter.NewCurryFunctionJSONPresenter),
+		configOption(configFile),
+	)
+	if err != nil {
+		return err
+	}
+
+	if out == StdioFileName {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+
+	// NOTE: JSON has no header to check whether it was generated by chapati
+	if err := checkOutputPath(in, out, force); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(out, b, 0666); err != nil {
+		return xerrors.Errorf("failed to write output file %s: %w", out, err)
+	}
+
+	return nil
+}
+
+// checkedFileName returns the file name of the output used in type-check errors.
+func checkedFileName(in, out string) string {
+	if out == StdioFileName {
+		return defaultOutputFile(sourceFileName(in))
+	}
+	return out
+}
+
+func testFileOf(out string) string {
+	return strings.TrimSuffix(out, ".go") + "_test.go"
+}
+
+func benchFileOf(out string) string {
+	return strings.TrimSuffix(out, ".go") + "_bench_test.go"
+}
+
+func writeOutput(in, out string, code []byte, force bool) error {
+	if out == StdioFileName {
+		_, err := os.Stdout.Write(code)
+		return err
+	}
+
+	if err := checkOutputFile(in, out, force); err != nil {
+		return err
+	}
+
+	// NOTE: the directory of another output package may not exist yet
+	if err := os.MkdirAll(filepath.Dir(out), 0777); err != nil {
+		return xerrors.Errorf("failed to create output directory: %w", err)
+	}
+
+	if err := os.WriteFile(out, code, 0666); err != nil {
+		return xerrors.Errorf("failed to write output file %s: %w", out, err)
+	}
+
+	return nil
+}
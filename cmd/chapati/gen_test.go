@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenOutputPackage(t *testing.T) {
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command is not found")
+	}
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"go.mod": "module example.com/t3\n\ngo 1.18\n",
+		"chapati.yaml": `packages:
+  - pattern: "example.com/t3/*"
+    output:
+      package: curried
+      path: example.com/t3/curried
+`,
+		"src/s.go": `package src
+
+type Point struct{ X, Y int }
+
+func Move(p Point, dx, dy int) Point {
+	return Point{X: p.X + dx, Y: p.Y + dy}
+}
+`,
+	})
+
+	// NOTE: packages in the module are imported from the current directory
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("failed to change directory: %v", err)
+	}
+	defer os.Chdir(wd)
+
+	if err := newGenCommand().Execute([]string{"-tests", filepath.Join("src", "s.go")}); err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	for _, name := range []string{"generate.curried.s.go", "generate.curried.s_test.go"} {
+		if _, err := os.Stat(filepath.Join(dir, "curried", name)); err != nil {
+			t.Errorf("%s must be generated in the output package: %v", name, err)
+		}
+	}
+
+	cmd := exec.Command(goCmd, "test", "./...")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("generated code must be built: %v\n%s", err, out)
+	}
+}
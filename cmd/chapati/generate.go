@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/di"
+	"github.com/syuparn/chapati/interface/controller"
+	"github.com/syuparn/chapati/interface/presenter"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// generate handles the input file and returns what the presenter wrote.
+// If src is not nil, it is used as the source code of in.
+// If in is a JSON/YAML spec, signatures are read from it instead of Go source code.
+func generate(in string, src []byte, opts ...di.Option) ([]byte, error) {
+	var buf bytes.Buffer
+	var herr error
+
+	if controller.IsSpecFile(in) {
+		opts = append([]di.Option{di.WithController(controller.NewCurryFunctionSpecController)}, opts...)
+	}
+
+	container := di.NewContainer(&buf, opts...)
+	derr := container.Invoke(func(c controller.CurryFunctionController) {
+		herr = handle(c, in, src)
+	})
+
+	if derr != nil {
+		return nil, xerrors.Errorf("di failed: %w", derr)
+	}
+
+	if herr != nil {
+		return nil, herr
+	}
+
+	return buf.Bytes(), nil
+}
+
+func handle(c controller.CurryFunctionController, in string, src []byte) error {
+	if src != nil {
+		return c.HandleSource(sourceFileName(in), bytes.NewReader(src))
+	}
+	if in == StdioFileName {
+		return c.HandleSource(stdinFileName, os.Stdin)
+	}
+	return c.Handle(in)
+}
+
+// readStdin reads source code from stdin if in is '-' (nil otherwise)
+// so that it can be handled more than once.
+func readStdin(in string) ([]byte, error) {
+	if in != StdioFileName {
+		return nil, nil
+	}
+
+	src, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read stdin: %w", err)
+	}
+	return src, nil
+}
+
+func sourceFileName(in string) string {
+	if in == StdioFileName {
+		return stdinFileName
+	}
+	return in
+}
+
+func defaultOutputFile(in string) string {
+	if in == StdioFileName {
+		return StdioFileName
+	}
+	base := filepath.Base(in)
+	if controller.IsSpecFile(in) {
+		base = strings.TrimSuffix(base, filepath.Ext(base)) + ".go"
+	}
+	return filepath.Join(filepath.Dir(in), DefaultOutputFilePrefix+base)
+}
+
+// outputFileOf returns the default output file of in.
+// If the configuration generates code in another package, the file is placed in the directory of the package.
+func outputFileOf(in, configFile string) (string, error) {
+	out := defaultOutputFile(in)
+	if in == StdioFileName {
+		return out, nil
+	}
+
+	dir, ok, err := controller.OutputDir(in, configControllerOption(configFile))
+	if err != nil {
+		return "", xerrors.Errorf("failed to resolve output directory: %w", err)
+	}
+	if !ok {
+		return out, nil
+	}
+
+	return filepath.Join(dir, filepath.Base(out)), nil
+}
+
+func addConfigFlag(fs *flag.FlagSet) *string {
+	return fs.String("config", "", "configuration file (default: '"+controller.ConfigFileName+"' searched from the input file directory to its ancestors)")
+}
+
+func configOption(configFile string) di.Option {
+	return di.WithControllerOptions(configControllerOption(configFile))
+}
+
+func configControllerOption(configFile string) controller.Option {
+	if configFile != "" {
+		return controller.WithConfigFile(configFile)
+	}
+	return controller.WithConfigSearch()
+}
+
+func addDeepFlag(fs *flag.FlagSet) *bool {
+	return fs.Bool("deep", false, "also curry functions returned by functions (e.g. 'func(a int) func(b, c string) error' is curried into 'func(a int) func(b string) func(c string) error')")
+}
+
+func deepOption(deep bool) di.Option {
+	if deep {
+		return di.WithControllerOptions(controller.WithDeepCurrying())
+	}
+	return di.WithControllerOptions()
+}
+
+func addStyleFlag(fs *flag.FlagSet) *string {
+	return fs.String("style", styleClosure, "style of partial application ('closure' returns nested closures, 'struct' returns struct types without allocation)")
+}
+
+// stylePresenters are constructors of presenters writing code, tests and benchmarks in a style.
+type stylePresenters struct {
+	code       func(io.Writer) usecase.CurryFunctionOutputPort
+	tests      func(io.Writer) usecase.CurryFunctionOutputPort
+	benchmarks func(io.Writer) usecase.CurryFunctionOutputPort
+}
+
+func stylePresentersOf(style string) (*stylePresenters, error) {
+	switch style {
+	case styleClosure:
+		return &stylePresenters{
+			code:       presenter.NewCurryFunctionPresenter,
+			tests:      presenter.NewCurryFunctionTestPresenter,
+			benchmarks: presenter.NewCurryFunctionBenchPresenter,
+		}, nil
+	case styleStruct:
+		return &stylePresenters{
+			code:       presenter.NewCurryFunctionStructPresenter,
+			tests:      presenter.NewCurryFunctionStructTestPresenter,
+			benchmarks: presenter.NewCurryFunctionStructBenchPresenter,
+		}, nil
+	default:
+		return nil, xerrors.Errorf("unknown style %q (must be %q or %q)", style, styleClosure, styleStruct)
+	}
+}
+
+func addTemplateFlag(fs *flag.FlagSet) *string {
+	return fs.String("template", "", "text/template file to render each generated file instead of the default code")
+}
+
+// templateOptions returns options to render code by the template file (nothing if templateFile is empty).
+func templateOptions(templateFile string) ([]di.Option, error) {
+	if templateFile == "" {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(templateFile)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read template: %w", err)
+	}
+
+	tmpl, err := presenter.ParseTemplate(filepath.Base(templateFile), string(b))
+	if err != nil {
+		return nil, xerrors.Errorf("%s: %w", templateFile, err)
+	}
+
+	return []di.Option{
+		di.WithPresenter(func(w io.Writer) usecase.CurryFunctionOutputPort {
+			return presenter.NewCurryFunctionTemplatePresenter(w, tmpl)
+		}),
+	}, nil
+}
+
+func addMemoizeFlag(fs *flag.FlagSet) *int {
+	return fs.Int("memoize", 0, "memoize each stage by its argument with caches holding at most the given number of entries (0 means no memoization)")
+}
+
+// codeFlags are flags deciding how Go code of curried functions is rendered.
+type codeFlags struct {
+	templateFile *string
+	style        *string
+	memoize      *int
+	must         *bool
+	result       *bool
+	async        *bool
+	hook         *string
+}
+
+func addCodeFlags(fs *flag.FlagSet) *codeFlags {
+	return &codeFlags{
+		templateFile: addTemplateFlag(fs),
+		style:        addStyleFlag(fs),
+		memoize:      addMemoizeFlag(fs),
+		must:         fs.Bool("must", false, "also generate 'MustCurriedX' panicking on error for functions returning error"),
+		result:       fs.Bool("result", false, "also generate 'ResultCurriedX' returning result.Result[T] for functions returning (T, error) (requires Go 1.18)"),
+		async:        fs.Bool("async", false, "also generate 'AsyncCurriedX' calling the original function in a new goroutine and returning future.Future[T] for functions returning at most one value and an error (requires Go 1.18)"),
+		hook:         fs.String("hook", "", "package-level hook.Interceptor variable in the output package called before and after the original function in curried functions"),
+	}
+}
+
+// isDefault returns true if no flags change the default code.
+func (f *codeFlags) isDefault() bool {
+	return *f.templateFile == "" && *f.style == styleClosure && *f.memoize == 0 && len(f.variants()) == 0 && *f.hook == ""
+}
+
+// variants returns variants of curried functions enabled by flags.
+func (f *codeFlags) variants() []presenter.Variant {
+	variants := []presenter.Variant{}
+	if *f.must {
+		variants = append(variants, presenter.MustVariant)
+	}
+	if *f.result {
+		variants = append(variants, presenter.ResultVariant)
+	}
+	if *f.async {
+		variants = append(variants, presenter.AsyncVariant)
+	}
+	return variants
+}
+
+// options returns options to render Go code by the template, in the style, with memoization, with variants or with the hook.
+func (f *codeFlags) options() ([]di.Option, error) {
+	templateFile, style, memoize := *f.templateFile, *f.style, *f.memoize
+
+	if templateFile != "" && style != styleClosure {
+		return nil, xerrors.Errorf("template cannot be used with style %q", style)
+	}
+
+	if memoize < 0 {
+		return nil, xerrors.Errorf("memoize must not be negative (got %d)", memoize)
+	}
+	if memoize > 0 {
+		// NOTE: stages of struct style are values which do not need to be memoized
+		if templateFile != "" || style != styleClosure {
+			return nil, xerrors.Errorf("memoize can only be used with style %q", styleClosure)
+		}
+		if len(f.variants()) > 0 || *f.hook != "" {
+			return nil, xerrors.Errorf("memoize cannot be used with must, result, async or hook")
+		}
+		return []di.Option{
+			di.WithPresenter(func(w io.Writer) usecase.CurryFunctionOutputPort {
+				return presenter.NewCurryFunctionMemoPresenter(w, memoize)
+			}),
+		}, nil
+	}
+
+	if hook := *f.hook; hook != "" {
+		if templateFile != "" || style != styleClosure || len(f.variants()) > 0 {
+			return nil, xerrors.Errorf("hook can only be used with style %q without template, must, result and async", styleClosure)
+		}
+		return []di.Option{
+			di.WithPresenter(func(w io.Writer) usecase.CurryFunctionOutputPort {
+				return presenter.NewCurryFunctionHookPresenter(w, hook)
+			}),
+		}, nil
+	}
+
+	if variants := f.variants(); len(variants) > 0 {
+		if templateFile != "" || style != styleClosure {
+			return nil, xerrors.Errorf("must, result and async can only be used with style %q", styleClosure)
+		}
+		return []di.Option{
+			di.WithPresenter(func(w io.Writer) usecase.CurryFunctionOutputPort {
+				return presenter.NewCurryFunctionVariantPresenter(w, variants...)
+			}),
+		}, nil
+	}
+
+	if templateFile != "" {
+		return templateOptions(templateFile)
+	}
+
+	presenters, err := stylePresentersOf(style)
+	if err != nil {
+		return nil, err
+	}
+	return []di.Option{di.WithPresenter(presenters.code)}, nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"os"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/di"
+	"github.com/syuparn/chapati/interface/controller"
+	"github.com/syuparn/chapati/interface/presenter"
+)
+
+func newListCommand() *command {
+	cmd := newCommand(
+		"list",
+		"list curryable functions with their arities and curried names",
+		"<inputfile>\n\n(use '-' as inputfile to read from stdin)",
+	)
+
+	configFile := addConfigFlag(cmd.flags)
+	deep := addDeepFlag(cmd.flags)
+
+	cmd.run = func(args []string) error {
+		if len(args) == 0 || args[0] == "" {
+			return xerrors.Errorf("input file name must not be empty")
+		}
+
+		if *deep && controller.IsSpecFile(args[0]) {
+			return xerrors.Errorf("deep cannot be used with specs")
+		}
+
+		list, err := generate(
+			args[0],
+			nil,
+			di.WithPresenter(presenter.NewCurryFunctionListPresenter),
+			configOption(*configFile),
+			deepOption(*deep),
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = os.Stdout.Write(list)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/interface/presenter"
+)
+
+// checkOutputFile checks whether the output file can be overwritten safely.
+func checkOutputFile(in, out string, force bool) error {
+	if force {
+		return nil
+	}
+
+	if err := checkOutputPath(in, out, force); err != nil {
+		return err
+	}
+
+	generated, err := isGeneratedFile(out)
+	if err != nil {
+		return xerrors.Errorf("failed to read output file %s: %w", out, err)
+	}
+	if !generated {
+		return xerrors.Errorf("output file %s was not generated by chapati (use -force to overwrite)", out)
+	}
+
+	return nil
+}
+
+// checkOutputPath checks whether the output file is inside the module root of the input file.
+func checkOutputPath(in, out string, force bool) error {
+	if force {
+		return nil
+	}
+
+	root, err := moduleRoot(filepath.Dir(in))
+	if err != nil {
+		return xerrors.Errorf("failed to find module root: %w", err)
+	}
+
+	inside, err := isInside(root, out)
+	if err != nil {
+		return xerrors.Errorf("failed to resolve output file path: %w", err)
+	}
+	if !inside {
+		return xerrors.Errorf("output file %s is outside the module root %s (use -force to write anyway)", out, root)
+	}
+
+	return nil
+}
+
+// moduleRoot returns the nearest directory containing go.mod walking up from dir.
+// If no go.mod is found, dir itself is regarded as the root.
+func moduleRoot(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for d := abs; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return d, nil
+		}
+
+		if filepath.Dir(d) == d {
+			return abs, nil
+		}
+	}
+}
+
+func isInside(root, path string) (bool, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false, err
+	}
+
+	rel, err := filepath.Rel(root, abs)
+	if err != nil {
+		return false, nil
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)), nil
+}
+
+// isGeneratedFile reports whether the file has the header written by chapati.
+// A file which does not exist yet can be written freely.
+func isGeneratedFile(fileName string) (bool, error) {
+	f, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	header := "// " + presenter.GeneratedCodeHeader
+
+	// the header must be placed before the package clause
+	// NOTE: bufio.Scanner cannot be used because it fails on lines longer than 64KiB
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadString('\n')
+		line = strings.TrimSpace(line)
+		if line == header {
+			return true, nil
+		}
+		if strings.HasPrefix(line, "package ") {
+			return false, nil
+		}
+
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+}
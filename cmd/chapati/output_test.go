@@ -0,0 +1,239 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckOutputFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		in      string
+		out     string
+		force   bool
+		succeed bool
+	}{
+		{
+			"output file does not exist",
+			map[string]string{
+				"go.mod":  "module example.com/m\n",
+				"main.go": "package main\n",
+			},
+			"main.go",
+			"generate.curried.main.go",
+			false,
+			true,
+		},
+		{
+			"output file was generated by chapati",
+			map[string]string{
+				"go.mod":                   "module example.com/m\n",
+				"main.go":                  "package main\n",
+				"generate.curried.main.go": "// Code generated by chapati; DO NOT EDIT.\n\npackage main\n",
+			},
+			"main.go",
+			"generate.curried.main.go",
+			false,
+			true,
+		},
+		{
+			"output file was not generated by chapati",
+			map[string]string{
+				"go.mod":  "module example.com/m\n",
+				"main.go": "package main\n",
+				"util.go": "package main\n\n// Code generated by chapati; DO NOT EDIT.\n",
+			},
+			"main.go",
+			"util.go",
+			false,
+			false,
+		},
+		{
+			"output file generated by chapati has a long line",
+			map[string]string{
+				"go.mod":                   "module example.com/m\n",
+				"main.go":                  "package main\n",
+				"generate.curried.main.go": "// " + strings.Repeat("a", 100*1024) + "\n// Code generated by chapati; DO NOT EDIT.\n\npackage main\n",
+			},
+			"main.go",
+			"generate.curried.main.go",
+			false,
+			true,
+		},
+		{
+			"output file was not generated by chapati with force",
+			map[string]string{
+				"go.mod":  "module example.com/m\n",
+				"main.go": "package main\n",
+				"util.go": "package main\n",
+			},
+			"main.go",
+			"util.go",
+			true,
+			true,
+		},
+		{
+			"input file in sub directory",
+			map[string]string{
+				"go.mod":      "module example.com/m\n",
+				"sub/main.go": "package main\n",
+			},
+			"sub/main.go",
+			"out.go",
+			false,
+			true,
+		},
+		{
+			"output file outside module root",
+			map[string]string{
+				"m/go.mod":  "module example.com/m\n",
+				"m/main.go": "package main\n",
+			},
+			"m/main.go",
+			"out.go",
+			false,
+			false,
+		},
+		{
+			"output file outside module root with force",
+			map[string]string{
+				"m/go.mod":  "module example.com/m\n",
+				"m/main.go": "package main\n",
+			},
+			"m/main.go",
+			"out.go",
+			true,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			err := checkOutputFile(filepath.Join(dir, tt.in), filepath.Join(dir, tt.out), tt.force)
+
+			if tt.succeed && err != nil {
+				t.Errorf("error must be nil: %v", err)
+			}
+			if !tt.succeed && err == nil {
+				t.Errorf("error must not be nil")
+			}
+		})
+	}
+}
+
+func TestIsGeneratedFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{
+			"generated",
+			"// Code generated by chapati; DO NOT EDIT.\n\npackage main\n",
+			true,
+		},
+		{
+			"not generated",
+			"package main\n",
+			false,
+		},
+		{
+			"no newline at the end",
+			"// Code generated by chapati; DO NOT EDIT.",
+			true,
+		},
+		{
+			"not generated with a line longer than 64KiB",
+			"package main\n\nvar s = \"" + strings.Repeat("a", 100*1024) + "\"\n",
+			false,
+		},
+		{
+			"long line before the package clause",
+			"// " + strings.Repeat("a", 100*1024) + "\npackage main\n",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, map[string]string{"out.go": tt.content})
+
+			actual, err := isGeneratedFile(filepath.Join(dir, "out.go"))
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCheckOutputPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		in      string
+		out     string
+		force   bool
+		succeed bool
+	}{
+		{
+			"output file exists",
+			map[string]string{
+				"go.mod":       "module example.com/m\n",
+				"main.go":      "package main\n",
+				"curried.json": "{}\n",
+			},
+			"main.go",
+			"curried.json",
+			false,
+			true,
+		},
+		{
+			"output file outside module root",
+			map[string]string{
+				"m/go.mod":  "module example.com/m\n",
+				"m/main.go": "package main\n",
+			},
+			"m/main.go",
+			"curried.json",
+			false,
+			false,
+		},
+		{
+			"output file outside module root with force",
+			map[string]string{
+				"m/go.mod":  "module example.com/m\n",
+				"m/main.go": "package main\n",
+			},
+			"m/main.go",
+			"curried.json",
+			true,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			err := checkOutputPath(filepath.Join(dir, tt.in), filepath.Join(dir, tt.out), tt.force)
+
+			if tt.succeed && err != nil {
+				t.Errorf("error must be nil: %v", err)
+			}
+			if !tt.succeed && err == nil {
+				t.Errorf("error must not be nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package combinator provides generic function combinators to chain curried functions.
+//
+//	parse := combinator.Pipe2E(strconv.Atoi, validate)
+//	n, err := parse("42")
+//
+// Combinators with the E suffix handle functions returning (T, error)
+// and stop calling the rest of functions once an error is returned.
+package combinator
+
+//go:generate go run ./internal/gen -o pipe_gen.go
+
+// Identity returns x as it is.
+func Identity[T any](x T) T {
+	return x
+}
+
+// Const returns a function which ignores its argument and always returns x.
+func Const[T, U any](x T) func(U) T {
+	return func(U) T {
+		return x
+	}
+}
+
+// Flip swaps the first two arguments of curried f.
+func Flip[A, B, R any](f func(A) func(B) R) func(B) func(A) R {
+	return func(b B) func(A) R {
+		return func(a A) R {
+			return f(a)(b)
+		}
+	}
+}
+
+// FlipE swaps the first two arguments of curried f returning (R, error).
+func FlipE[A, B, R any](f func(A) func(B) (R, error)) func(B) func(A) (R, error) {
+	return func(b B) func(A) (R, error) {
+		return func(a A) (R, error) {
+			return f(a)(b)
+		}
+	}
+}
+
+// Apply calls f with a.
+func Apply[A, R any](f func(A) R, a A) R {
+	return f(a)
+}
+
+// Compose returns a function which calls f and then g (g after f).
+func Compose[A, B, C any](g func(B) C, f func(A) B) func(A) C {
+	return func(a A) C {
+		return g(f(a))
+	}
+}
+
+// ComposeE is Compose for functions returning (T, error).
+// g is not called if f returns an error.
+func ComposeE[A, B, C any](g func(B) (C, error), f func(A) (B, error)) func(A) (C, error) {
+	return func(a A) (C, error) {
+		b, err := f(a)
+		if err != nil {
+			var zero C
+			return zero, err
+		}
+		return g(b)
+	}
+}
@@ -0,0 +1,169 @@
+package combinator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/syuparn/chapati/curry"
+)
+
+func TestCombinators(t *testing.T) {
+	sub := curry.Curry2(func(a, b int) int { return a - b })
+	double := func(n int) int { return n * 2 }
+
+	tests := []struct {
+		name     string
+		actual   interface{}
+		expected interface{}
+	}{
+		{
+			"Identity",
+			Identity("a"),
+			"a",
+		},
+		{
+			"Const",
+			Const[int, string](1)("ignored"),
+			1,
+		},
+		{
+			"Flip",
+			Flip(sub)(1)(5),
+			4,
+		},
+		{
+			"Apply",
+			Apply(sub(5), 1),
+			4,
+		},
+		{
+			"Compose",
+			Compose(strconv.Itoa, double)(21),
+			"42",
+		},
+		{
+			"Pipe2",
+			Pipe2(double, strconv.Itoa)(21),
+			"42",
+		},
+		{
+			"Pipe3 with curried functions",
+			Pipe3(sub(10), double, strconv.Itoa)(4),
+			"12",
+		},
+		{
+			"Pipe10",
+			Pipe10(double, double, double, double, double, double, double, double, double, double)(1),
+			1024,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.actual, tt.expected) {
+				t.Errorf("wrong value: expected %v, got %v", tt.expected, tt.actual)
+			}
+		})
+	}
+}
+
+func TestPipeE(t *testing.T) {
+	errNegative := errors.New("negative")
+	called := false
+
+	validate := func(n int) (int, error) {
+		if n < 0 {
+			return 0, errNegative
+		}
+		return n, nil
+	}
+	format := func(n int) (string, error) {
+		called = true
+		return strconv.Itoa(n), nil
+	}
+	parse := Pipe3E(strconv.Atoi, validate, format)
+
+	tests := []struct {
+		name           string
+		in             string
+		expected       string
+		expectedErr    error
+		expectedCalled bool
+	}{
+		{
+			"no errors",
+			"42",
+			"42",
+			nil,
+			true,
+		},
+		{
+			"short-circuit",
+			"-1",
+			"",
+			errNegative,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			actual, err := parse(tt.in)
+
+			if err != tt.expectedErr {
+				t.Fatalf("wrong error: expected %v, got %v", tt.expectedErr, err)
+			}
+
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %v, got %v", tt.expected, actual)
+			}
+
+			if called != tt.expectedCalled {
+				t.Errorf("wrong value: expected called=%v, got %v", tt.expectedCalled, called)
+			}
+		})
+	}
+}
+
+func TestComposeEAndFlipE(t *testing.T) {
+	split := curry.Curry2E(func(s string, sep string) ([]string, error) {
+		if sep == "" {
+			return nil, errors.New("empty separator")
+		}
+		return strings.Split(s, sep), nil
+	})
+	count := func(s []string) (int, error) { return len(s), nil }
+
+	n, err := ComposeE(count, FlipE(split)(","))("a,b,c")
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("wrong value: expected %v, got %v", 3, n)
+	}
+
+	if _, err := ComposeE(count, FlipE(split)(""))("a,b,c"); err == nil {
+		t.Errorf("error must not be nil")
+	}
+}
+
+func ExamplePipe2E() {
+	half := func(n int) (int, error) {
+		if n%2 != 0 {
+			return 0, fmt.Errorf("%d is odd", n)
+		}
+		return n / 2, nil
+	}
+	parse := Pipe2E(strconv.Atoi, half)
+
+	fmt.Println(parse("42"))
+	fmt.Println(parse("3"))
+	// Output:
+	// 21 <nil>
+	// 0 3 is odd
+}
@@ -0,0 +1,141 @@
+// Command gen generates Pipe2..PipeN of package combinator.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+	"text/template"
+
+	"golang.org/x/xerrors"
+)
+
+// header is the header comment of the generated file.
+// NOTE: the header differs from chapati's one so that chapati does not regard the file as its output
+const header = "Code generated by combinator/internal/gen; DO NOT EDIT."
+
+// maxFuncs is the max number of functions piped by generated helpers.
+const maxFuncs = 10
+
+const pipeTemplate = `// {{.Header}}
+
+package combinator
+{{range .Pipes}}{{$p := .}}
+// Pipe{{.N}} returns a function which calls functions from f1 to f{{.N}} in order.
+func Pipe{{.N}}[{{join .Types ", "}} any]({{range $i, $f := .Funcs}}{{if $i}}, {{end}}{{$f.Name}} func({{$f.In}}) {{$f.Out}}{{end}}) func({{.In}}) {{.Out}} {
+	return func(x {{.In}}) {{.Out}} {
+		return {{range $i := .Reversed}}f{{$i}}({{end}}x{{range .Funcs}}){{end}}
+	}
+}
+
+// Pipe{{.N}}E is Pipe{{.N}} for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe{{.N}}E[{{join .Types ", "}} any]({{range $i, $f := .Funcs}}{{if $i}}, {{end}}{{$f.Name}} func({{$f.In}}) ({{$f.Out}}, error){{end}}) func({{.In}}) ({{.Out}}, error) {
+	return func(x0 {{.In}}) ({{.Out}}, error) {
+		{{- range $i, $f := .Funcs}}
+		x{{inc $i}}, err := {{$f.Name}}(x{{$i}})
+		if err != nil {
+			var zero {{$p.Out}}
+			return zero, err
+		}
+		{{- end}}
+		return x{{.N}}, nil
+	}
+}
+{{end}}`
+
+type pipe struct {
+	N     int
+	Types []string
+	Funcs []*pipedFunc
+	In    string
+	Out   string
+}
+
+type pipedFunc struct {
+	Name string
+	In   string
+	Out  string
+}
+
+// Reversed returns indices of functions in reversed order (for nested calls).
+func (p *pipe) Reversed() []int {
+	indices := make([]int, p.N)
+	for i := range indices {
+		indices[i] = p.N - i
+	}
+	return indices
+}
+
+type pipeData struct {
+	Header string
+	Pipes  []*pipe
+}
+
+// typeParamNames are names of type parameters.
+var typeParamNames = []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K"}
+
+func main() {
+	out := flag.String("o", "pipe_gen.go", "output file name")
+	flag.Parse()
+
+	code, err := generate()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(*out, code, 0666); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write %s: %v\n", *out, err)
+		os.Exit(1)
+	}
+}
+
+func generate() ([]byte, error) {
+	tmpl, err := template.New("pipe").Funcs(template.FuncMap{
+		"join": strings.Join,
+		"inc":  func(i int) int { return i + 1 },
+	}).Parse(pipeTemplate)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse template: %w", err)
+	}
+
+	data := &pipeData{Header: header}
+	for n := 2; n <= maxFuncs; n++ {
+		data.Pipes = append(data.Pipes, pipeOf(n))
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, xerrors.Errorf("failed to execute template: %w", err)
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, xerrors.Errorf("generated code cannot be formatted: %w\n%s", err, buf.String())
+	}
+
+	return code, nil
+}
+
+func pipeOf(n int) *pipe {
+	p := &pipe{
+		N:     n,
+		Types: typeParamNames[:n+1],
+		In:    typeParamNames[0],
+		Out:   typeParamNames[n],
+	}
+
+	for i := 0; i < n; i++ {
+		p.Funcs = append(p.Funcs, &pipedFunc{
+			Name: fmt.Sprintf("f%d", i+1),
+			In:   typeParamNames[i],
+			Out:  typeParamNames[i+1],
+		})
+	}
+
+	return p
+}
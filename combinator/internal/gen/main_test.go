@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedCodeIsUpToDate(t *testing.T) {
+	expected, err := generate()
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	actual, err := os.ReadFile("../../pipe_gen.go")
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	if !bytes.Equal(actual, expected) {
+		t.Errorf("pipe_gen.go is out of date (run 'go generate ./combinator')")
+	}
+}
+
+func TestGeneratedCodeHeader(t *testing.T) {
+	code, err := generate()
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	// NOTE: chapati must not regard the file as its output (e.g. chapati clean removes it)
+	expected := "// " + header + "\n"
+	if !bytes.HasPrefix(code, []byte(expected)) {
+		t.Errorf("wrong value: expected to start with %q, got %q", expected, code[:bytes.IndexByte(code, '\n')+1])
+	}
+}
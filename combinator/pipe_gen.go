@@ -0,0 +1,408 @@
+// Code generated by combinator/internal/gen; DO NOT EDIT.
+
+package combinator
+
+// Pipe2 returns a function which calls functions from f1 to f2 in order.
+func Pipe2[A, B, C any](f1 func(A) B, f2 func(B) C) func(A) C {
+	return func(x A) C {
+		return f2(f1(x))
+	}
+}
+
+// Pipe2E is Pipe2 for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe2E[A, B, C any](f1 func(A) (B, error), f2 func(B) (C, error)) func(A) (C, error) {
+	return func(x0 A) (C, error) {
+		x1, err := f1(x0)
+		if err != nil {
+			var zero C
+			return zero, err
+		}
+		x2, err := f2(x1)
+		if err != nil {
+			var zero C
+			return zero, err
+		}
+		return x2, nil
+	}
+}
+
+// Pipe3 returns a function which calls functions from f1 to f3 in order.
+func Pipe3[A, B, C, D any](f1 func(A) B, f2 func(B) C, f3 func(C) D) func(A) D {
+	return func(x A) D {
+		return f3(f2(f1(x)))
+	}
+}
+
+// Pipe3E is Pipe3 for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe3E[A, B, C, D any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error)) func(A) (D, error) {
+	return func(x0 A) (D, error) {
+		x1, err := f1(x0)
+		if err != nil {
+			var zero D
+			return zero, err
+		}
+		x2, err := f2(x1)
+		if err != nil {
+			var zero D
+			return zero, err
+		}
+		x3, err := f3(x2)
+		if err != nil {
+			var zero D
+			return zero, err
+		}
+		return x3, nil
+	}
+}
+
+// Pipe4 returns a function which calls functions from f1 to f4 in order.
+func Pipe4[A, B, C, D, E any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E) func(A) E {
+	return func(x A) E {
+		return f4(f3(f2(f1(x))))
+	}
+}
+
+// Pipe4E is Pipe4 for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe4E[A, B, C, D, E any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error)) func(A) (E, error) {
+	return func(x0 A) (E, error) {
+		x1, err := f1(x0)
+		if err != nil {
+			var zero E
+			return zero, err
+		}
+		x2, err := f2(x1)
+		if err != nil {
+			var zero E
+			return zero, err
+		}
+		x3, err := f3(x2)
+		if err != nil {
+			var zero E
+			return zero, err
+		}
+		x4, err := f4(x3)
+		if err != nil {
+			var zero E
+			return zero, err
+		}
+		return x4, nil
+	}
+}
+
+// Pipe5 returns a function which calls functions from f1 to f5 in order.
+func Pipe5[A, B, C, D, E, F any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F) func(A) F {
+	return func(x A) F {
+		return f5(f4(f3(f2(f1(x)))))
+	}
+}
+
+// Pipe5E is Pipe5 for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe5E[A, B, C, D, E, F any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error)) func(A) (F, error) {
+	return func(x0 A) (F, error) {
+		x1, err := f1(x0)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		x2, err := f2(x1)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		x3, err := f3(x2)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		x4, err := f4(x3)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		x5, err := f5(x4)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		return x5, nil
+	}
+}
+
+// Pipe6 returns a function which calls functions from f1 to f6 in order.
+func Pipe6[A, B, C, D, E, F, G any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G) func(A) G {
+	return func(x A) G {
+		return f6(f5(f4(f3(f2(f1(x))))))
+	}
+}
+
+// Pipe6E is Pipe6 for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe6E[A, B, C, D, E, F, G any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error), f6 func(F) (G, error)) func(A) (G, error) {
+	return func(x0 A) (G, error) {
+		x1, err := f1(x0)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		x2, err := f2(x1)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		x3, err := f3(x2)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		x4, err := f4(x3)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		x5, err := f5(x4)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		x6, err := f6(x5)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		return x6, nil
+	}
+}
+
+// Pipe7 returns a function which calls functions from f1 to f7 in order.
+func Pipe7[A, B, C, D, E, F, G, H any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H) func(A) H {
+	return func(x A) H {
+		return f7(f6(f5(f4(f3(f2(f1(x)))))))
+	}
+}
+
+// Pipe7E is Pipe7 for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe7E[A, B, C, D, E, F, G, H any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error), f6 func(F) (G, error), f7 func(G) (H, error)) func(A) (H, error) {
+	return func(x0 A) (H, error) {
+		x1, err := f1(x0)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		x2, err := f2(x1)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		x3, err := f3(x2)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		x4, err := f4(x3)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		x5, err := f5(x4)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		x6, err := f6(x5)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		x7, err := f7(x6)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		return x7, nil
+	}
+}
+
+// Pipe8 returns a function which calls functions from f1 to f8 in order.
+func Pipe8[A, B, C, D, E, F, G, H, I any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I) func(A) I {
+	return func(x A) I {
+		return f8(f7(f6(f5(f4(f3(f2(f1(x))))))))
+	}
+}
+
+// Pipe8E is Pipe8 for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe8E[A, B, C, D, E, F, G, H, I any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error), f6 func(F) (G, error), f7 func(G) (H, error), f8 func(H) (I, error)) func(A) (I, error) {
+	return func(x0 A) (I, error) {
+		x1, err := f1(x0)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		x2, err := f2(x1)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		x3, err := f3(x2)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		x4, err := f4(x3)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		x5, err := f5(x4)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		x6, err := f6(x5)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		x7, err := f7(x6)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		x8, err := f8(x7)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		return x8, nil
+	}
+}
+
+// Pipe9 returns a function which calls functions from f1 to f9 in order.
+func Pipe9[A, B, C, D, E, F, G, H, I, J any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I, f9 func(I) J) func(A) J {
+	return func(x A) J {
+		return f9(f8(f7(f6(f5(f4(f3(f2(f1(x)))))))))
+	}
+}
+
+// Pipe9E is Pipe9 for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe9E[A, B, C, D, E, F, G, H, I, J any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error), f6 func(F) (G, error), f7 func(G) (H, error), f8 func(H) (I, error), f9 func(I) (J, error)) func(A) (J, error) {
+	return func(x0 A) (J, error) {
+		x1, err := f1(x0)
+		if err != nil {
+			var zero J
+			return zero, err
+		}
+		x2, err := f2(x1)
+		if err != nil {
+			var zero J
+			return zero, err
+		}
+		x3, err := f3(x2)
+		if err != nil {
+			var zero J
+			return zero, err
+		}
+		x4, err := f4(x3)
+		if err != nil {
+			var zero J
+			return zero, err
+		}
+		x5, err := f5(x4)
+		if err != nil {
+			var zero J
+			return zero, err
+		}
+		x6, err := f6(x5)
+		if err != nil {
+			var zero J
+			return zero, err
+		}
+		x7, err := f7(x6)
+		if err != nil {
+			var zero J
+			return zero, err
+		}
+		x8, err := f8(x7)
+		if err != nil {
+			var zero J
+			return zero, err
+		}
+		x9, err := f9(x8)
+		if err != nil {
+			var zero J
+			return zero, err
+		}
+		return x9, nil
+	}
+}
+
+// Pipe10 returns a function which calls functions from f1 to f10 in order.
+func Pipe10[A, B, C, D, E, F, G, H, I, J, K any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I, f9 func(I) J, f10 func(J) K) func(A) K {
+	return func(x A) K {
+		return f10(f9(f8(f7(f6(f5(f4(f3(f2(f1(x))))))))))
+	}
+}
+
+// Pipe10E is Pipe10 for functions returning (T, error).
+// The rest of functions are not called once an error is returned.
+func Pipe10E[A, B, C, D, E, F, G, H, I, J, K any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error), f6 func(F) (G, error), f7 func(G) (H, error), f8 func(H) (I, error), f9 func(I) (J, error), f10 func(J) (K, error)) func(A) (K, error) {
+	return func(x0 A) (K, error) {
+		x1, err := f1(x0)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		x2, err := f2(x1)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		x3, err := f3(x2)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		x4, err := f4(x3)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		x5, err := f5(x4)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		x6, err := f6(x5)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		x7, err := f7(x6)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		x8, err := f8(x7)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		x9, err := f9(x8)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		x10, err := f10(x9)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		return x10, nil
+	}
+}
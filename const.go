@@ -1,4 +0,0 @@
-package main
-
-// DefaultOutputFilePrefix is added to output file name.
-const DefaultOutputFilePrefix = "generate.curried."
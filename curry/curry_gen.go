@@ -0,0 +1,435 @@
+// Code generated by curry/internal/gen; DO NOT EDIT.
+
+package curry
+
+// Curry2 converts f into the curried form.
+func Curry2[A, B, R any](f func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return func(b B) R {
+			return f(a, b)
+		}
+	}
+}
+
+// Uncurry2 converts curried f into the original form.
+func Uncurry2[A, B, R any](f func(A) func(B) R) func(A, B) R {
+	return func(a A, b B) R {
+		return f(a)(b)
+	}
+}
+
+// Curry2E converts f into the curried form.
+func Curry2E[A, B, R any](f func(A, B) (R, error)) func(A) func(B) (R, error) {
+	return func(a A) func(B) (R, error) {
+		return func(b B) (R, error) {
+			return f(a, b)
+		}
+	}
+}
+
+// Uncurry2E converts curried f into the original form.
+func Uncurry2E[A, B, R any](f func(A) func(B) (R, error)) func(A, B) (R, error) {
+	return func(a A, b B) (R, error) {
+		return f(a)(b)
+	}
+}
+
+// Curry3 converts f into the curried form.
+func Curry3[A, B, C, R any](f func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return func(b B) func(C) R {
+			return func(c C) R {
+				return f(a, b, c)
+			}
+		}
+	}
+}
+
+// Uncurry3 converts curried f into the original form.
+func Uncurry3[A, B, C, R any](f func(A) func(B) func(C) R) func(A, B, C) R {
+	return func(a A, b B, c C) R {
+		return f(a)(b)(c)
+	}
+}
+
+// Curry3E converts f into the curried form.
+func Curry3E[A, B, C, R any](f func(A, B, C) (R, error)) func(A) func(B) func(C) (R, error) {
+	return func(a A) func(B) func(C) (R, error) {
+		return func(b B) func(C) (R, error) {
+			return func(c C) (R, error) {
+				return f(a, b, c)
+			}
+		}
+	}
+}
+
+// Uncurry3E converts curried f into the original form.
+func Uncurry3E[A, B, C, R any](f func(A) func(B) func(C) (R, error)) func(A, B, C) (R, error) {
+	return func(a A, b B, c C) (R, error) {
+		return f(a)(b)(c)
+	}
+}
+
+// Curry4 converts f into the curried form.
+func Curry4[A, B, C, D, R any](f func(A, B, C, D) R) func(A) func(B) func(C) func(D) R {
+	return func(a A) func(B) func(C) func(D) R {
+		return func(b B) func(C) func(D) R {
+			return func(c C) func(D) R {
+				return func(d D) R {
+					return f(a, b, c, d)
+				}
+			}
+		}
+	}
+}
+
+// Uncurry4 converts curried f into the original form.
+func Uncurry4[A, B, C, D, R any](f func(A) func(B) func(C) func(D) R) func(A, B, C, D) R {
+	return func(a A, b B, c C, d D) R {
+		return f(a)(b)(c)(d)
+	}
+}
+
+// Curry4E converts f into the curried form.
+func Curry4E[A, B, C, D, R any](f func(A, B, C, D) (R, error)) func(A) func(B) func(C) func(D) (R, error) {
+	return func(a A) func(B) func(C) func(D) (R, error) {
+		return func(b B) func(C) func(D) (R, error) {
+			return func(c C) func(D) (R, error) {
+				return func(d D) (R, error) {
+					return f(a, b, c, d)
+				}
+			}
+		}
+	}
+}
+
+// Uncurry4E converts curried f into the original form.
+func Uncurry4E[A, B, C, D, R any](f func(A) func(B) func(C) func(D) (R, error)) func(A, B, C, D) (R, error) {
+	return func(a A, b B, c C, d D) (R, error) {
+		return f(a)(b)(c)(d)
+	}
+}
+
+// Curry5 converts f into the curried form.
+func Curry5[A, B, C, D, E, R any](f func(A, B, C, D, E) R) func(A) func(B) func(C) func(D) func(E) R {
+	return func(a A) func(B) func(C) func(D) func(E) R {
+		return func(b B) func(C) func(D) func(E) R {
+			return func(c C) func(D) func(E) R {
+				return func(d D) func(E) R {
+					return func(e E) R {
+						return f(a, b, c, d, e)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry5 converts curried f into the original form.
+func Uncurry5[A, B, C, D, E, R any](f func(A) func(B) func(C) func(D) func(E) R) func(A, B, C, D, E) R {
+	return func(a A, b B, c C, d D, e E) R {
+		return f(a)(b)(c)(d)(e)
+	}
+}
+
+// Curry5E converts f into the curried form.
+func Curry5E[A, B, C, D, E, R any](f func(A, B, C, D, E) (R, error)) func(A) func(B) func(C) func(D) func(E) (R, error) {
+	return func(a A) func(B) func(C) func(D) func(E) (R, error) {
+		return func(b B) func(C) func(D) func(E) (R, error) {
+			return func(c C) func(D) func(E) (R, error) {
+				return func(d D) func(E) (R, error) {
+					return func(e E) (R, error) {
+						return f(a, b, c, d, e)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry5E converts curried f into the original form.
+func Uncurry5E[A, B, C, D, E, R any](f func(A) func(B) func(C) func(D) func(E) (R, error)) func(A, B, C, D, E) (R, error) {
+	return func(a A, b B, c C, d D, e E) (R, error) {
+		return f(a)(b)(c)(d)(e)
+	}
+}
+
+// Curry6 converts f into the curried form.
+func Curry6[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) R) func(A) func(B) func(C) func(D) func(E) func(F) R {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) R {
+		return func(b B) func(C) func(D) func(E) func(F) R {
+			return func(c C) func(D) func(E) func(F) R {
+				return func(d D) func(E) func(F) R {
+					return func(e E) func(F) R {
+						return func(f1 F) R {
+							return f(a, b, c, d, e, f1)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry6 converts curried f into the original form.
+func Uncurry6[A, B, C, D, E, F, R any](f func(A) func(B) func(C) func(D) func(E) func(F) R) func(A, B, C, D, E, F) R {
+	return func(a A, b B, c C, d D, e E, f1 F) R {
+		return f(a)(b)(c)(d)(e)(f1)
+	}
+}
+
+// Curry6E converts f into the curried form.
+func Curry6E[A, B, C, D, E, F, R any](f func(A, B, C, D, E, F) (R, error)) func(A) func(B) func(C) func(D) func(E) func(F) (R, error) {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) (R, error) {
+		return func(b B) func(C) func(D) func(E) func(F) (R, error) {
+			return func(c C) func(D) func(E) func(F) (R, error) {
+				return func(d D) func(E) func(F) (R, error) {
+					return func(e E) func(F) (R, error) {
+						return func(f1 F) (R, error) {
+							return f(a, b, c, d, e, f1)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry6E converts curried f into the original form.
+func Uncurry6E[A, B, C, D, E, F, R any](f func(A) func(B) func(C) func(D) func(E) func(F) (R, error)) func(A, B, C, D, E, F) (R, error) {
+	return func(a A, b B, c C, d D, e E, f1 F) (R, error) {
+		return f(a)(b)(c)(d)(e)(f1)
+	}
+}
+
+// Curry7 converts f into the curried form.
+func Curry7[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) R) func(A) func(B) func(C) func(D) func(E) func(F) func(G) R {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) func(G) R {
+		return func(b B) func(C) func(D) func(E) func(F) func(G) R {
+			return func(c C) func(D) func(E) func(F) func(G) R {
+				return func(d D) func(E) func(F) func(G) R {
+					return func(e E) func(F) func(G) R {
+						return func(f1 F) func(G) R {
+							return func(g G) R {
+								return f(a, b, c, d, e, f1, g)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry7 converts curried f into the original form.
+func Uncurry7[A, B, C, D, E, F, G, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) R) func(A, B, C, D, E, F, G) R {
+	return func(a A, b B, c C, d D, e E, f1 F, g G) R {
+		return f(a)(b)(c)(d)(e)(f1)(g)
+	}
+}
+
+// Curry7E converts f into the curried form.
+func Curry7E[A, B, C, D, E, F, G, R any](f func(A, B, C, D, E, F, G) (R, error)) func(A) func(B) func(C) func(D) func(E) func(F) func(G) (R, error) {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) func(G) (R, error) {
+		return func(b B) func(C) func(D) func(E) func(F) func(G) (R, error) {
+			return func(c C) func(D) func(E) func(F) func(G) (R, error) {
+				return func(d D) func(E) func(F) func(G) (R, error) {
+					return func(e E) func(F) func(G) (R, error) {
+						return func(f1 F) func(G) (R, error) {
+							return func(g G) (R, error) {
+								return f(a, b, c, d, e, f1, g)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry7E converts curried f into the original form.
+func Uncurry7E[A, B, C, D, E, F, G, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) (R, error)) func(A, B, C, D, E, F, G) (R, error) {
+	return func(a A, b B, c C, d D, e E, f1 F, g G) (R, error) {
+		return f(a)(b)(c)(d)(e)(f1)(g)
+	}
+}
+
+// Curry8 converts f into the curried form.
+func Curry8[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) R) func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) R {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) R {
+		return func(b B) func(C) func(D) func(E) func(F) func(G) func(H) R {
+			return func(c C) func(D) func(E) func(F) func(G) func(H) R {
+				return func(d D) func(E) func(F) func(G) func(H) R {
+					return func(e E) func(F) func(G) func(H) R {
+						return func(f1 F) func(G) func(H) R {
+							return func(g G) func(H) R {
+								return func(h H) R {
+									return f(a, b, c, d, e, f1, g, h)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry8 converts curried f into the original form.
+func Uncurry8[A, B, C, D, E, F, G, H, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) R) func(A, B, C, D, E, F, G, H) R {
+	return func(a A, b B, c C, d D, e E, f1 F, g G, h H) R {
+		return f(a)(b)(c)(d)(e)(f1)(g)(h)
+	}
+}
+
+// Curry8E converts f into the curried form.
+func Curry8E[A, B, C, D, E, F, G, H, R any](f func(A, B, C, D, E, F, G, H) (R, error)) func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) (R, error) {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) (R, error) {
+		return func(b B) func(C) func(D) func(E) func(F) func(G) func(H) (R, error) {
+			return func(c C) func(D) func(E) func(F) func(G) func(H) (R, error) {
+				return func(d D) func(E) func(F) func(G) func(H) (R, error) {
+					return func(e E) func(F) func(G) func(H) (R, error) {
+						return func(f1 F) func(G) func(H) (R, error) {
+							return func(g G) func(H) (R, error) {
+								return func(h H) (R, error) {
+									return f(a, b, c, d, e, f1, g, h)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry8E converts curried f into the original form.
+func Uncurry8E[A, B, C, D, E, F, G, H, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) (R, error)) func(A, B, C, D, E, F, G, H) (R, error) {
+	return func(a A, b B, c C, d D, e E, f1 F, g G, h H) (R, error) {
+		return f(a)(b)(c)(d)(e)(f1)(g)(h)
+	}
+}
+
+// Curry9 converts f into the curried form.
+func Curry9[A, B, C, D, E, F, G, H, I, R any](f func(A, B, C, D, E, F, G, H, I) R) func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) R {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) R {
+		return func(b B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) R {
+			return func(c C) func(D) func(E) func(F) func(G) func(H) func(I) R {
+				return func(d D) func(E) func(F) func(G) func(H) func(I) R {
+					return func(e E) func(F) func(G) func(H) func(I) R {
+						return func(f1 F) func(G) func(H) func(I) R {
+							return func(g G) func(H) func(I) R {
+								return func(h H) func(I) R {
+									return func(i I) R {
+										return f(a, b, c, d, e, f1, g, h, i)
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry9 converts curried f into the original form.
+func Uncurry9[A, B, C, D, E, F, G, H, I, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) R) func(A, B, C, D, E, F, G, H, I) R {
+	return func(a A, b B, c C, d D, e E, f1 F, g G, h H, i I) R {
+		return f(a)(b)(c)(d)(e)(f1)(g)(h)(i)
+	}
+}
+
+// Curry9E converts f into the curried form.
+func Curry9E[A, B, C, D, E, F, G, H, I, R any](f func(A, B, C, D, E, F, G, H, I) (R, error)) func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) (R, error) {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) (R, error) {
+		return func(b B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) (R, error) {
+			return func(c C) func(D) func(E) func(F) func(G) func(H) func(I) (R, error) {
+				return func(d D) func(E) func(F) func(G) func(H) func(I) (R, error) {
+					return func(e E) func(F) func(G) func(H) func(I) (R, error) {
+						return func(f1 F) func(G) func(H) func(I) (R, error) {
+							return func(g G) func(H) func(I) (R, error) {
+								return func(h H) func(I) (R, error) {
+									return func(i I) (R, error) {
+										return f(a, b, c, d, e, f1, g, h, i)
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry9E converts curried f into the original form.
+func Uncurry9E[A, B, C, D, E, F, G, H, I, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) (R, error)) func(A, B, C, D, E, F, G, H, I) (R, error) {
+	return func(a A, b B, c C, d D, e E, f1 F, g G, h H, i I) (R, error) {
+		return f(a)(b)(c)(d)(e)(f1)(g)(h)(i)
+	}
+}
+
+// Curry10 converts f into the curried form.
+func Curry10[A, B, C, D, E, F, G, H, I, J, R any](f func(A, B, C, D, E, F, G, H, I, J) R) func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) R {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) R {
+		return func(b B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) R {
+			return func(c C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) R {
+				return func(d D) func(E) func(F) func(G) func(H) func(I) func(J) R {
+					return func(e E) func(F) func(G) func(H) func(I) func(J) R {
+						return func(f1 F) func(G) func(H) func(I) func(J) R {
+							return func(g G) func(H) func(I) func(J) R {
+								return func(h H) func(I) func(J) R {
+									return func(i I) func(J) R {
+										return func(j J) R {
+											return f(a, b, c, d, e, f1, g, h, i, j)
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry10 converts curried f into the original form.
+func Uncurry10[A, B, C, D, E, F, G, H, I, J, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) R) func(A, B, C, D, E, F, G, H, I, J) R {
+	return func(a A, b B, c C, d D, e E, f1 F, g G, h H, i I, j J) R {
+		return f(a)(b)(c)(d)(e)(f1)(g)(h)(i)(j)
+	}
+}
+
+// Curry10E converts f into the curried form.
+func Curry10E[A, B, C, D, E, F, G, H, I, J, R any](f func(A, B, C, D, E, F, G, H, I, J) (R, error)) func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) (R, error) {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) (R, error) {
+		return func(b B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) (R, error) {
+			return func(c C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) (R, error) {
+				return func(d D) func(E) func(F) func(G) func(H) func(I) func(J) (R, error) {
+					return func(e E) func(F) func(G) func(H) func(I) func(J) (R, error) {
+						return func(f1 F) func(G) func(H) func(I) func(J) (R, error) {
+							return func(g G) func(H) func(I) func(J) (R, error) {
+								return func(h H) func(I) func(J) (R, error) {
+									return func(i I) func(J) (R, error) {
+										return func(j J) (R, error) {
+											return f(a, b, c, d, e, f1, g, h, i, j)
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry10E converts curried f into the original form.
+func Uncurry10E[A, B, C, D, E, F, G, H, I, J, R any](f func(A) func(B) func(C) func(D) func(E) func(F) func(G) func(H) func(I) func(J) (R, error)) func(A, B, C, D, E, F, G, H, I, J) (R, error) {
+	return func(a A, b B, c C, d D, e E, f1 F, g G, h H, i I, j J) (R, error) {
+		return f(a)(b)(c)(d)(e)(f1)(g)(h)(i)(j)
+	}
+}
@@ -0,0 +1,112 @@
+package curry
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCurry(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   interface{}
+		expected interface{}
+	}{
+		{
+			"Curry2",
+			Curry2(func(a, b int) int { return a - b })(5)(3),
+			2,
+		},
+		{
+			"Curry3",
+			Curry3(func(s string, n int, sep string) string {
+				return strings.Repeat(s+sep, n)
+			})("a")(3)(","),
+			"a,a,a,",
+		},
+		{
+			"Curry10",
+			Curry10(func(a, b, c, d, e, f, g, h, i, j int) []int {
+				return []int{a, b, c, d, e, f, g, h, i, j}
+			})(0)(1)(2)(3)(4)(5)(6)(7)(8)(9),
+			[]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			"Uncurry2",
+			Uncurry2(func(a int) func(int) int {
+				return func(b int) int { return a - b }
+			})(5, 3),
+			2,
+		},
+		{
+			"round trip",
+			Uncurry3(Curry3(func(a, b, c string) string { return a + b + c }))("x", "y", "z"),
+			"xyz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.actual, tt.expected) {
+				t.Errorf("wrong value: expected %v, got %v", tt.expected, tt.actual)
+			}
+		})
+	}
+}
+
+func TestCurryE(t *testing.T) {
+	errZeroDivision := errors.New("division by zero")
+	div := func(a, b int) (int, error) {
+		if b == 0 {
+			return 0, errZeroDivision
+		}
+		return a / b, nil
+	}
+
+	tests := []struct {
+		name        string
+		f           func(int, int) (int, error)
+		a, b        int
+		expected    int
+		expectedErr error
+	}{
+		{
+			"Curry2E",
+			Uncurry2E(Curry2E(div)),
+			6, 3,
+			2,
+			nil,
+		},
+		{
+			"error",
+			Uncurry2E(Curry2E(div)),
+			6, 0,
+			0,
+			errZeroDivision,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.f(tt.a, tt.b)
+
+			if err != tt.expectedErr {
+				t.Fatalf("wrong error: expected %v, got %v", tt.expectedErr, err)
+			}
+
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func ExampleCurry2() {
+	add := func(a, b int) int { return a + b }
+	inc := Curry2(add)(1)
+
+	fmt.Println(inc(2))
+	// Output: 3
+}
@@ -0,0 +1,10 @@
+// Package curry provides generic helpers to curry and uncurry functions without code generation.
+//
+//	add := func(a, b int) int { return a + b }
+//	inc := curry.Curry2(add)(1)
+//	inc(2) // 3
+//
+// Functions returning (R, error) are handled by the E variants (e.g. Curry2E).
+package curry
+
+//go:generate go run ./internal/gen -o curry_gen.go
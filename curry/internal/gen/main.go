@@ -0,0 +1,137 @@
+// Command gen generates helpers of package curry by chapati's template presenter
+// so that curried functions are the same as generated code.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/infrastructure"
+	"github.com/syuparn/chapati/interface/presenter"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// header is the header comment of the generated file.
+// NOTE: the header differs from chapati's one so that chapati does not regard the file as its output
+const header = "Code generated by curry/internal/gen; DO NOT EDIT."
+
+// maxArity is the max arity of generated helpers.
+const maxArity = 10
+
+const curryTemplate = `
+{{define "typeParams"}}{{range .OriginalSignatureList.Parameters}}{{type .Type}}, {{end}}R any{{end}}
+
+{{range .Functions}}{{$fn := .}}{{with .CurriedSignatureList.CurriedSignature}}
+// {{.Name}} converts f into the curried form.
+func {{.Name}}[{{template "typeParams" $fn}}](f {{type $fn.OriginalSignatureList.Type}}) {{type .Type}} {
+	return func({{params .}}) {{results .}} {
+		{{body $fn}}
+	}
+}
+
+// Uncurry{{slice .Name 5}} converts curried f into the original form.
+func Uncurry{{slice .Name 5}}[{{template "typeParams" $fn}}](f {{type .Type}}) {{type $fn.OriginalSignatureList.Type}} {
+	return func({{params $fn.OriginalSignatureList}}) {{results $fn.OriginalSignatureList}} {
+		return f{{range stages $fn}}({{args .}}){{end}}
+	}
+}
+{{end}}{{end}}
+`
+
+// typeParamNames are names of type parameters (and lowercases are parameter names).
+var typeParamNames = []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J"}
+
+func main() {
+	out := flag.String("o", "curry_gen.go", "output file name")
+	flag.Parse()
+
+	code, err := generate()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(*out, code, 0666); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write %s: %v\n", *out, err)
+		os.Exit(1)
+	}
+}
+
+func generate() ([]byte, error) {
+	tmpl, err := presenter.ParseTemplate("curry", curryTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	service := infrastructure.NewCurryService()
+	functions := []*usecase.CurriedFunctionOutputData{}
+
+	for arity := 2; arity <= maxArity; arity++ {
+		for _, returnTypes := range [][]domain.Type{
+			{domain.TermType("R")},
+			{domain.TermType("R"), domain.TermType("error")},
+		} {
+			fn, err := curriedFunction(service, arity, returnTypes)
+			if err != nil {
+				return nil, err
+			}
+			functions = append(functions, fn)
+		}
+	}
+
+	var buf bytes.Buffer
+	p := presenter.NewCurryFunctionTemplatePresenter(&buf, tmpl)
+
+	err = p.Show(&usecase.CurryFunctionOutputData{
+		Functions: functions,
+		CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+			PackageName: "curry",
+			PackagePath: "github.com/syuparn/chapati/curry",
+		},
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("failed to generate code: %w", err)
+	}
+
+	// NOTE: the template presenter always writes chapati's header
+	chapatiHeader := []byte("// " + presenter.GeneratedCodeHeader + "\n")
+	if !bytes.HasPrefix(buf.Bytes(), chapatiHeader) {
+		return nil, xerrors.Errorf("generated code does not start with %q", chapatiHeader)
+	}
+
+	return append([]byte("// "+header+"\n"), bytes.TrimPrefix(buf.Bytes(), chapatiHeader)...), nil
+}
+
+func curriedFunction(
+	service domain.CurryService,
+	arity int,
+	returnTypes []domain.Type,
+) (*usecase.CurriedFunctionOutputData, error) {
+	params := make([]domain.Parameter, arity)
+	for i := range params {
+		params[i] = domain.NewParameter(strings.ToLower(typeParamNames[i]), domain.TermType(typeParamNames[i]))
+	}
+
+	name := fmt.Sprintf("Curry%d", arity)
+	if len(returnTypes) > 1 {
+		name += "E"
+	}
+
+	// NOTE: curried functions call the argument f
+	orig := domain.NewFunctionSignature("f", params, returnTypes)
+	curried, err := service.Curry(orig, name)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to curry %s: %w", name, err)
+	}
+
+	return &usecase.CurriedFunctionOutputData{
+		OriginalSignatureList: orig,
+		CurriedSignatureList:  curried,
+	}, nil
+}
@@ -11,8 +11,51 @@ import (
 	"github.com/syuparn/chapati/usecase"
 )
 
+type config struct {
+	presenterConstructor  interface{}
+	controllerConstructor ControllerConstructor
+	controllerOptions     []controller.Option
+}
+
+// ControllerConstructor is a constructor of controller.CurryFunctionController.
+type ControllerConstructor func(usecase.CurryFunctionInputPort, ...controller.Option) controller.CurryFunctionController
+
+// Option is an optional setting of the DI container.
+type Option func(*config)
+
+// WithPresenter replaces the constructor of usecase.CurryFunctionOutputPort.
+// The constructor can receive io.Writer passed to NewContainer.
+func WithPresenter(constructor interface{}) Option {
+	return func(c *config) {
+		c.presenterConstructor = constructor
+	}
+}
+
+// WithController replaces the constructor of controller.CurryFunctionController
+// (e.g. controller.NewCurryFunctionSpecController).
+func WithController(constructor ControllerConstructor) Option {
+	return func(c *config) {
+		c.controllerConstructor = constructor
+	}
+}
+
+// WithControllerOptions passes options to the controller.
+func WithControllerOptions(opts ...controller.Option) Option {
+	return func(c *config) {
+		c.controllerOptions = append(c.controllerOptions, opts...)
+	}
+}
+
 // NewContainer creates a new DI container.
-func NewContainer(w io.Writer) *dig.Container {
+func NewContainer(w io.Writer, opts ...Option) *dig.Container {
+	conf := &config{
+		presenterConstructor:  presenter.NewCurryFunctionPresenter,
+		controllerConstructor: controller.NewCurryFunctionController,
+	}
+	for _, opt := range opts {
+		opt(conf)
+	}
+
 	c := dig.New()
 
 	// domain
@@ -20,10 +63,12 @@ func NewContainer(w io.Writer) *dig.Container {
 
 	// usecase
 	c.Provide(usecase.NewCurryFunctionInputPort)
-	c.Provide(presenter.NewCurryFunctionPresenter)
+	c.Provide(conf.presenterConstructor)
 
 	// interface
-	c.Provide(controller.NewCurryFunctionController)
+	c.Provide(func(inputPort usecase.CurryFunctionInputPort) controller.CurryFunctionController {
+		return conf.controllerConstructor(inputPort, conf.controllerOptions...)
+	})
 
 	// writer
 	c.Provide(func() io.Writer { return w })
@@ -5,15 +5,49 @@ import (
 	"testing"
 
 	"github.com/syuparn/chapati/interface/controller"
+	"github.com/syuparn/chapati/interface/presenter"
 )
 
 func TestDI(t *testing.T) {
-	container := NewContainer(os.Stdout)
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{
+			"default",
+			[]Option{},
+		},
+		{
+			"with options",
+			[]Option{
+				WithPresenter(presenter.NewCurryFunctionListPresenter),
+				WithControllerOptions(controller.WithCurriedFuncPrefix("C")),
+			},
+		},
+		{
+			"json presenter",
+			[]Option{
+				WithPresenter(presenter.NewCurryFunctionJSONPresenter),
+			},
+		},
+		{
+			"spec controller",
+			[]Option{
+				WithController(controller.NewCurryFunctionSpecController),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container := NewContainer(os.Stdout, tt.opts...)
 
-	err := container.Invoke(func(c controller.CurryFunctionController) {
-		// noop
-	})
-	if err != nil {
-		t.Errorf("failed to invoke controller: %v", err)
+			err := container.Invoke(func(c controller.CurryFunctionController) {
+				// noop
+			})
+			if err != nil {
+				t.Errorf("failed to invoke controller: %v", err)
+			}
+		})
 	}
 }
@@ -1,5 +1,7 @@
 package domain
 
+import "fmt"
+
 // FunctionSignature represents a signature format of a function.
 type FunctionSignature struct {
 	name        string
@@ -52,3 +54,31 @@ func NewFunctionSignature(
 		returnTypes: returnTypes,
 	}
 }
+
+// Reorder returns a copy of the signature whose parameters are sorted in the order of names.
+func (s *FunctionSignature) Reorder(names []string) (*FunctionSignature, error) {
+	if len(names) != len(s.params) {
+		return nil, fmt.Errorf("names must contain all %d parameters (got %d)", len(s.params), len(names))
+	}
+
+	params := make([]Parameter, len(names))
+	used := make([]bool, len(s.params))
+
+	for i, name := range names {
+		found := false
+		for j, param := range s.params {
+			if !used[j] && param.Name == name {
+				params[i] = param
+				used[j] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("parameter %s is not found", name)
+		}
+	}
+
+	return NewFunctionSignature(s.name, params, s.ReturnTypes()), nil
+}
@@ -12,7 +12,7 @@ type Type interface {
 type TermType string
 
 func (t TermType) String() string {
-	return t.String()
+	return string(t)
 }
 
 // Type is a dummy method of Type interface.
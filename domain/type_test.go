@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTermTypeString(t *testing.T) {
+	tests := []struct {
+		t        TermType
+		expected string
+	}{
+		{TermType("int"), "int"},
+		{TermType("map[string]*io.Writer"), "map[string]*io.Writer"},
+		{TermType(""), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			actual := tt.t.String()
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %s, got %s", tt.expected, actual)
+			}
+
+			// NOTE: TermType is formatted by String in %v
+			formatted := fmt.Sprintf("%v", tt.t)
+			if formatted != tt.expected {
+				t.Errorf("wrong value: expected %s, got %s", tt.expected, formatted)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 package example
 
+// Add returns the sum of i1 and i2.
 func Add(i1, i2 int) int {
 	return i1 + i2
 }
@@ -2,6 +2,9 @@
 
 package example
 
+// CurriedAdd is the curried form of Add.
+//
+// Add returns the sum of i1 and i2.
 func CurriedAdd(i1 int) func(int) int {
 	return func(i2 int) int {
 		return Add(i1, i2)
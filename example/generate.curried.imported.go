@@ -3,10 +3,11 @@
 package example
 
 import (
-	jen "github.com/dave/jennifer/jen"
+	"github.com/dave/jennifer/jen"
 	"io"
 )
 
+// CurriedWithImport is the curried form of withImport.
 func CurriedWithImport(c jen.Code) func(io.Writer) error {
 	return func(w io.Writer) error {
 		return withImport(c, w)
@@ -0,0 +1,7 @@
+// Package partial is an example of curried functions generated with -style struct.
+package partial
+
+// Add returns the sum of i1 and i2.
+func Add(i1, i2 int) int {
+	return i1 + i2
+}
@@ -0,0 +1,43 @@
+package partial_test
+
+import (
+	"testing"
+
+	"github.com/syuparn/chapati/example"
+	"github.com/syuparn/chapati/example/partial"
+)
+
+// NOTE: partially applied stages are stored in sinks so that they escape as in real use
+var (
+	closureSink func(int) int
+	structSink  partial.AddP1
+	resultSink  int
+)
+
+func TestCurriedAdd(t *testing.T) {
+	for i := -3; i <= 3; i++ {
+		for j := -3; j <= 3; j++ {
+			actual := partial.CurriedAdd(i).Apply(j)
+			expected := example.CurriedAdd(i)(j)
+			if actual != expected {
+				t.Errorf("wrong value: expected %d, got %d", expected, actual)
+			}
+		}
+	}
+}
+
+func BenchmarkClosureStyle(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		closureSink = example.CurriedAdd(i)
+		resultSink = closureSink(i)
+	}
+}
+
+func BenchmarkStructStyle(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		structSink = partial.CurriedAdd(i)
+		resultSink = structSink.Apply(i)
+	}
+}
@@ -0,0 +1,77 @@
+// Package future provides Future, a result of a function running in another goroutine,
+// returned by curried functions generated with chapati gen -async (Go 1.18 or later).
+//
+//	// AsyncCurriedFetch(url)(opts) calls Fetch(url, opts) in a new goroutine
+//	f := AsyncCurriedFetch(url)(opts)
+//	res, err := f.Wait(ctx)
+package future
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// Future is a value and an error which will be returned by a function running in another goroutine.
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// Go calls f in a new goroutine and returns its future.
+// If f panics, the panic is recovered and returned as *PanicError by Wait.
+func Go[T any](f func() (T, error)) *Future[T] {
+	fut := &Future[T]{done: make(chan struct{})}
+
+	go func() {
+		defer close(fut.done)
+		// NOTE: recovered before done is closed so that Wait returns the panic
+		defer func() {
+			if r := recover(); r != nil {
+				fut.err = &PanicError{Value: r, Stack: debug.Stack()}
+			}
+		}()
+
+		fut.value, fut.err = f()
+	}()
+
+	return fut
+}
+
+// Done returns a channel closed when the function returns.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait waits for the function and returns its value and error.
+// If ctx is done first, ctx.Err() is returned (the function keeps running).
+func (f *Future[T]) Wait(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// PanicError is a panic recovered in the goroutine of Future.
+type PanicError struct {
+	// Value is the value passed to panic
+	Value interface{}
+	// Stack is the stack trace of the goroutine where the panic occurred
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns Value if it is an error (nil otherwise).
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var errNotFound = errors.New("not found")
+
+func TestFutureWait(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        func() (int, error)
+		expected int
+		err      error
+	}{
+		{
+			"value",
+			func() (int, error) { return 1, nil },
+			1,
+			nil,
+		},
+		{
+			"error",
+			func() (int, error) { return 0, errNotFound },
+			0,
+			errNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := Go(tt.f).Wait(context.Background())
+
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("wrong error: expected %v, got %v", tt.err, err)
+			}
+
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %d, got %d", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFutureWaitPanic(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+		unwrap   error
+	}{
+		{
+			"panic with a string",
+			"boom",
+			"panic: boom",
+			nil,
+		},
+		{
+			"panic with an error",
+			errNotFound,
+			"panic: not found",
+			errNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Go(func() (int, error) { panic(tt.value) }).Wait(context.Background())
+
+			var perr *PanicError
+			if !errors.As(err, &perr) {
+				t.Fatalf("error must be *PanicError (got %T)", err)
+			}
+
+			if perr.Error() != tt.expected {
+				t.Errorf("wrong value: expected %q, got %q", tt.expected, perr.Error())
+			}
+
+			if perr.Unwrap() != tt.unwrap {
+				t.Errorf("wrong value: expected %v, got %v", tt.unwrap, perr.Unwrap())
+			}
+
+			if !strings.Contains(string(perr.Stack), "future.TestFutureWaitPanic") {
+				t.Errorf("stack must contain the panicking function: %s", perr.Stack)
+			}
+		})
+	}
+}
+
+func TestFutureWaitCanceled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	f := Go(func() (int, error) {
+		<-release
+		return 1, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := f.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wrong value: expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	select {
+	case <-f.Done():
+		t.Errorf("Done must not be closed before the function returns")
+	default:
+	}
+}
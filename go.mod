@@ -1,10 +1,11 @@
 module github.com/syuparn/chapati
 
-go 1.16
+go 1.18
 
 require (
 	github.com/dave/jennifer v1.4.1
 	github.com/lithammer/dedent v1.1.0
 	go.uber.org/dig v1.10.0
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
+	gopkg.in/yaml.v3 v3.0.1
 )
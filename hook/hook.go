@@ -0,0 +1,61 @@
+// Package hook provides Interceptor called around the original functions in curried functions
+// generated with chapati gen -hook.
+//
+//	// the variable named by -hook in the package of generated code
+//	var curryHook hook.Interceptor = hook.Funcs{
+//		AfterFunc: func(name string, results []interface{}, d time.Duration) {
+//			log.Printf("%s returned %v in %s", name, results, d)
+//		},
+//	}
+package hook
+
+import "time"
+
+// Interceptor is called before and after the original function is called in the curried function.
+type Interceptor interface {
+	// Before is called with the name and the arguments of the original function.
+	Before(name string, args []interface{})
+	// After is called with the name, the returned values and the elapsed time of the original function
+	// (not called if the original function panics).
+	After(name string, results []interface{}, duration time.Duration)
+}
+
+// Funcs is an Interceptor calling BeforeFunc and AfterFunc (nil funcs are skipped).
+type Funcs struct {
+	BeforeFunc func(name string, args []interface{})
+	AfterFunc  func(name string, results []interface{}, duration time.Duration)
+}
+
+// Before calls f.BeforeFunc if it is not nil.
+func (f Funcs) Before(name string, args []interface{}) {
+	if f.BeforeFunc != nil {
+		f.BeforeFunc(name, args)
+	}
+}
+
+// After calls f.AfterFunc if it is not nil.
+func (f Funcs) After(name string, results []interface{}, duration time.Duration) {
+	if f.AfterFunc != nil {
+		f.AfterFunc(name, results, duration)
+	}
+}
+
+type chain []Interceptor
+
+// Chain returns an Interceptor calling Before of interceptors in order
+// and After of them in reverse order (like nested middlewares).
+func Chain(interceptors ...Interceptor) Interceptor {
+	return chain(interceptors)
+}
+
+func (c chain) Before(name string, args []interface{}) {
+	for _, i := range c {
+		i.Before(name, args)
+	}
+}
+
+func (c chain) After(name string, results []interface{}, duration time.Duration) {
+	for n := len(c) - 1; n >= 0; n-- {
+		c[n].After(name, results, duration)
+	}
+}
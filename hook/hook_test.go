@@ -0,0 +1,37 @@
+package hook
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func recorder(label string, calls *[]string) Interceptor {
+	return Funcs{
+		BeforeFunc: func(name string, args []interface{}) {
+			*calls = append(*calls, fmt.Sprintf("%s.Before(%s, %v)", label, name, args))
+		},
+		AfterFunc: func(name string, results []interface{}, duration time.Duration) {
+			*calls = append(*calls, fmt.Sprintf("%s.After(%s, %v)", label, name, results))
+		},
+	}
+}
+
+func TestChain(t *testing.T) {
+	calls := []string{}
+	h := Chain(recorder("a", &calls), Funcs{}, recorder("b", &calls))
+
+	h.Before("Add", []interface{}{1, 2})
+	h.After("Add", []interface{}{3}, time.Millisecond)
+
+	expected := []string{
+		"a.Before(Add, [1 2])",
+		"b.Before(Add, [1 2])",
+		"b.After(Add, [3])",
+		"a.After(Add, [3])",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("wrong value: expected %v, got %v", expected, calls)
+	}
+}
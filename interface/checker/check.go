@@ -0,0 +1,210 @@
+// Package checker type-checks generated code before it is written.
+package checker
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// maxReportedErrors is the number of type errors reported at once.
+const maxReportedErrors = 10
+
+// File is a generated source file.
+type File struct {
+	// Name is the file name where the code is written.
+	Name string
+	Src  []byte
+}
+
+// Check type-checks generated files together with the package of the source file.
+// If src is not nil, it is used as the content of fileName and the other files
+// in the package are not loaded.
+// Only errors in generated files are reported.
+func Check(fileName string, src []byte, generated ...File) error {
+	fset := token.NewFileSet()
+
+	generatedFiles := make([]*ast.File, len(generated))
+	lines := map[string][]string{}
+
+	for i, g := range generated {
+		f, err := parser.ParseFile(fset, g.Name, g.Src, parser.ParseComments)
+		if err != nil {
+			return xerrors.Errorf("generated code cannot be parsed:\n%s", formatParseError(err, g))
+		}
+
+		generatedFiles[i] = f
+		lines[g.Name] = strings.Split(string(g.Src), "\n")
+	}
+
+	// NOTE: src is passed as interface{} to distinguish nil from empty source
+	var source interface{}
+	if src != nil {
+		source = src
+	}
+
+	origFile, err := parser.ParseFile(fset, fileName, source, parser.ParseComments)
+	if err != nil {
+		return xerrors.Errorf("failed to parse source code: %w", err)
+	}
+
+	files := generatedFiles
+	packageName := origFile.Name.Name
+	if len(generatedFiles) > 0 && generatedFiles[0].Name.Name == packageName {
+		// NOTE: generated code is in the same package as the source
+		siblings, err := packageFiles(fset, fileName, src != nil, packageName, generated)
+		if err != nil {
+			return xerrors.Errorf("failed to load package: %w", err)
+		}
+
+		files = append(append(files, origFile), siblings...)
+	} else if len(generatedFiles) > 0 {
+		// NOTE: generated code in another package imports the source package
+		packageName = generatedFiles[0].Name.Name
+		if absPath(filepath.Dir(generated[0].Name)) == absPath(filepath.Dir(fileName)) {
+			return xerrors.Errorf("generated code of package %s cannot be placed in the directory of package %s", packageName, origFile.Name.Name)
+		}
+
+		siblings, err := packageFiles(fset, generated[0].Name, false, packageName, generated)
+		if err != nil {
+			return xerrors.Errorf("failed to load output package: %w", err)
+		}
+
+		files = append(files, siblings...)
+	}
+
+	typeErrors := []types.Error{}
+	conf := types.Config{
+		// NOTE: use "source" to import directly from source
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			if typeErr, ok := err.(types.Error); ok {
+				typeErrors = append(typeErrors, typeErr)
+			}
+		},
+	}
+
+	// NOTE: errors are collected by conf.Error
+	conf.Check(packageName, fset, files, nil)
+
+	messages := []string{}
+	for _, typeErr := range typeErrors {
+		pos := typeErr.Fset.Position(typeErr.Pos)
+
+		fileLines, ok := lines[pos.Filename]
+		if !ok {
+			// NOTE: errors in the original package are out of scope
+			continue
+		}
+
+		messages = append(messages, formatError(pos, typeErr.Msg, fileLines))
+		if len(messages) == maxReportedErrors {
+			break
+		}
+	}
+
+	if len(messages) > 0 {
+		return xerrors.Errorf("generated code does not compile:\n%s", strings.Join(messages, "\n"))
+	}
+
+	return nil
+}
+
+// packageFiles parses the other files in the package of fileName.
+func packageFiles(
+	fset *token.FileSet,
+	fileName string,
+	sourceOnly bool,
+	packageName string,
+	generated []File,
+) ([]*ast.File, error) {
+	if sourceOnly {
+		return []*ast.File{}, nil
+	}
+
+	dir := filepath.Dir(fileName)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return []*ast.File{}, nil
+	}
+
+	pkg, err := build.ImportDir(dir, build.IgnoreVendor)
+	if err != nil {
+		if _, ok := err.(*build.NoGoError); ok {
+			return []*ast.File{}, nil
+		}
+		// NOTE: ignore other packages in the same directory (e.g. package main with build tag ignore)
+		if _, ok := err.(*build.MultiplePackageError); !ok {
+			return nil, err
+		}
+	}
+
+	// NOTE: old generated files are replaced with new ones
+	excluded := map[string]bool{absPath(fileName): true}
+	for _, g := range generated {
+		excluded[absPath(g.Name)] = true
+	}
+
+	files := []*ast.File{}
+	for _, name := range pkg.GoFiles {
+		path := filepath.Join(dir, name)
+		if excluded[absPath(path)] {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		if f.Name.Name == packageName {
+			files = append(files, f)
+		}
+	}
+
+	return files, nil
+}
+
+func absPath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	return abs
+}
+
+func formatError(pos token.Position, msg string, lines []string) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s: %s", pos, msg)
+
+	if pos.Line >= 1 && pos.Line <= len(lines) {
+		fmt.Fprintf(&buf, "\n\t%s", strings.TrimSpace(lines[pos.Line-1]))
+	}
+
+	return buf.String()
+}
+
+func formatParseError(err error, g File) string {
+	list, ok := err.(scanner.ErrorList)
+	if !ok {
+		return err.Error()
+	}
+
+	lines := strings.Split(string(g.Src), "\n")
+	messages := make([]string, len(list))
+	for i, e := range list {
+		messages[i] = formatError(e.Pos, e.Msg, lines)
+	}
+
+	return strings.Join(messages, "\n")
+}
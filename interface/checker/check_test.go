@@ -0,0 +1,244 @@
+package checker
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		src       []byte
+		generated []File
+	}{
+		{
+			"generated code compiles",
+			map[string]string{
+				"add.go": "package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n",
+			},
+			nil,
+			[]File{
+				{
+					Name: "generate.curried.add.go",
+					Src:  []byte("package add\n\nfunc CurriedAdd(i1 int) func(int) int {\n\treturn func(i2 int) int { return Add(i1, i2) }\n}\n"),
+				},
+			},
+		},
+		{
+			"generated code refers to another file in the package",
+			map[string]string{
+				"add.go":  "package add\n\nfunc Add(i1, i2 Num) Num { return i1 + i2 }\n",
+				"type.go": "package add\n\ntype Num int\n",
+			},
+			nil,
+			[]File{
+				{
+					Name: "generate.curried.add.go",
+					Src:  []byte("package add\n\nfunc CurriedAdd(i1 Num) func(Num) Num {\n\treturn func(i2 Num) Num { return Add(i1, i2) }\n}\n"),
+				},
+			},
+		},
+		{
+			"old generated file is replaced",
+			map[string]string{
+				"add.go":                  "package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n",
+				"generate.curried.add.go": "package add\n\nfunc CurriedAdd() {}\n",
+			},
+			nil,
+			[]File{
+				{
+					Name: "generate.curried.add.go",
+					Src:  []byte("package add\n\nfunc CurriedAdd(i1 int) func(int) int {\n\treturn func(i2 int) int { return Add(i1, i2) }\n}\n"),
+				},
+			},
+		},
+		{
+			"generated code in another package refers to the output package",
+			map[string]string{
+				"add.go":          "package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n",
+				"curried/base.go": "package curried\n\nconst Base = 1\n",
+			},
+			nil,
+			[]File{
+				{
+					Name: "curried/generate.curried.add.go",
+					Src:  []byte("package curried\n\nfunc CurriedBase(i1 int) func(int) int {\n\treturn func(i2 int) int { return Base + i1 + i2 }\n}\n"),
+				},
+			},
+		},
+		{
+			"directory of another package does not exist yet",
+			map[string]string{
+				"add.go": "package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n",
+			},
+			nil,
+			[]File{
+				{
+					Name: "curried/generate.curried.add.go",
+					Src:  []byte("package curried\n\nfunc CurriedID(i1 int) func(int) int {\n\treturn func(i2 int) int { return i1 + i2 }\n}\n"),
+				},
+			},
+		},
+		{
+			"source is given",
+			map[string]string{},
+			[]byte("package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n"),
+			[]File{
+				{
+					Name: "generate.curried.add.go",
+					Src:  []byte("package add\n\nfunc CurriedAdd(i1 int) func(int) int {\n\treturn func(i2 int) int { return Add(i1, i2) }\n}\n"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			err := Check(filepath.Join(dir, "add.go"), tt.src, inDir(dir, tt.generated)...)
+
+			if err != nil {
+				t.Errorf("error must be nil: %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckFailed(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		generated []File
+		expected  []string
+	}{
+		{
+			"type mismatch",
+			map[string]string{
+				"add.go": "package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n",
+			},
+			[]File{
+				{
+					Name: "generate.curried.add.go",
+					Src:  []byte("package add\n\nfunc CurriedAdd(i1 int) func(string) int {\n\treturn func(i2 string) int { return Add(i1, i2) }\n}\n"),
+				},
+			},
+			[]string{
+				"generated code does not compile:",
+				"generate.curried.add.go:4:",
+				"\treturn func(i2 string) int { return Add(i1, i2) }",
+			},
+		},
+		{
+			"undefined type",
+			map[string]string{
+				"add.go": "package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n",
+			},
+			[]File{
+				{
+					Name: "generate.curried.add.go",
+					Src:  []byte("package add\n\nfunc CurriedAdd(i1 foo.Num) func(int) int {\n\treturn func(i2 int) int { return 0 }\n}\n"),
+				},
+			},
+			[]string{
+				"generate.curried.add.go:3:",
+				"\tfunc CurriedAdd(i1 foo.Num) func(int) int {",
+			},
+		},
+		{
+			"syntax error",
+			map[string]string{
+				"add.go": "package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n",
+			},
+			[]File{
+				{
+					Name: "generate.curried.add.go",
+					Src:  []byte("package add\n\nfunc CurriedAdd(i1 int) func(int) int {\n"),
+				},
+			},
+			[]string{
+				"generated code cannot be parsed:",
+			},
+		},
+		{
+			"another package in the directory of the source",
+			map[string]string{
+				"add.go": "package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n",
+			},
+			[]File{
+				{
+					Name: "generate.curried.add.go",
+					Src:  []byte("package curried\n\nfunc CurriedID(i1 int) func(int) int {\n\treturn func(i2 int) int { return i1 + i2 }\n}\n"),
+				},
+			},
+			[]string{
+				"generated code of package curried cannot be placed in the directory of package add",
+			},
+		},
+		{
+			"error in test file",
+			map[string]string{
+				"add.go": "package add\n\nfunc Add(i1, i2 int) int { return i1 + i2 }\n",
+			},
+			[]File{
+				{
+					Name: "generate.curried.add.go",
+					Src:  []byte("package add\n\nfunc CurriedAdd(i1 int) func(int) int {\n\treturn func(i2 int) int { return Add(i1, i2) }\n}\n"),
+				},
+				{
+					Name: "generate.curried.add_test.go",
+					Src:  []byte("package add\n\nfunc f() int {\n\treturn CurriedAdd(1)(2, 3)\n}\n"),
+				},
+			},
+			[]string{
+				"generate.curried.add_test.go:4:",
+				"\treturn CurriedAdd(1)(2, 3)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			err := Check(filepath.Join(dir, "add.go"), nil, inDir(dir, tt.generated)...)
+
+			if err == nil {
+				t.Fatalf("error must not be nil")
+			}
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("wrong value: expected %q is contained, got %q", expected, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func inDir(dir string, files []File) []File {
+	joined := make([]File, len(files))
+	for i, f := range files {
+		joined[i] = File{Name: filepath.Join(dir, f.Name), Src: f.Src}
+	}
+	return joined
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"go/ast"
+	"go/build/constraint"
+	"path/filepath"
+	"strings"
+)
+
+// NOTE: copied from go/build (internal/syslist)
+var knownOS = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true,
+	"hurd": true, "illumos": true, "ios": true, "js": true, "linux": true, "nacl": true,
+	"netbsd": true, "openbsd": true, "plan9": true, "solaris": true, "wasip1": true,
+	"windows": true, "zos": true,
+}
+
+var knownArch = map[string]bool{
+	"386": true, "amd64": true, "amd64p32": true, "arm": true, "armbe": true, "arm64": true,
+	"arm64be": true, "loong64": true, "mips": true, "mipsle": true, "mips64": true,
+	"mips64le": true, "mips64p32": true, "mips64p32le": true, "ppc": true, "ppc64": true,
+	"ppc64le": true, "riscv": true, "riscv64": true, "s390": true, "s390x": true,
+	"sparc": true, "sparc64": true, "wasm": true,
+}
+
+// buildConstraintsOf returns build constraint lines of the source file.
+// GOOS/GOARCH suffixes of the file name are converted into the constraint
+// because they do not work in generated file names
+// (go/build ignores everything after the first dot such as "generate.curried.foo_linux.go").
+func buildConstraintsOf(fileName string, f *ast.File) []string {
+	goBuild := []string{}
+	plusBuild := []string{}
+
+	for _, group := range f.Comments {
+		if group.End() >= f.Package {
+			break
+		}
+
+		for _, c := range group.List {
+			switch {
+			case constraint.IsGoBuild(c.Text):
+				goBuild = append(goBuild, c.Text)
+			case constraint.IsPlusBuild(c.Text):
+				plusBuild = append(plusBuild, c.Text)
+			}
+		}
+	}
+
+	fileExpr, ok := fileNameConstraint(fileName)
+	if !ok {
+		if len(goBuild)+len(plusBuild) == 0 {
+			return nil
+		}
+		return append(goBuild, plusBuild...)
+	}
+
+	expr := fileExpr
+	if sourceExpr, ok := parseConstraints(goBuild, plusBuild); ok {
+		expr = &constraint.AndExpr{X: sourceExpr, Y: fileExpr}
+	}
+
+	lines := []string{"//go:build " + expr.String()}
+	if len(plusBuild) == 0 {
+		return lines
+	}
+
+	plusLines, err := constraint.PlusBuildLines(expr)
+	if err != nil {
+		// NOTE: "// +build" lines are only for old Go versions
+		return lines
+	}
+	return append(lines, plusLines...)
+}
+
+// parseConstraints parses "//go:build" line (or "// +build" lines if it does not exist).
+func parseConstraints(goBuild, plusBuild []string) (constraint.Expr, bool) {
+	lines := goBuild
+	if len(lines) == 0 {
+		lines = plusBuild
+	}
+
+	var expr constraint.Expr
+	for _, line := range lines {
+		e, err := constraint.Parse(line)
+		if err != nil {
+			continue
+		}
+
+		if expr == nil {
+			expr = e
+			continue
+		}
+		// NOTE: multiple "// +build" lines are ANDed
+		expr = &constraint.AndExpr{X: expr, Y: e}
+	}
+
+	return expr, expr != nil
+}
+
+// fileNameConstraint returns the constraint implied by the file name
+// (*_GOOS, *_GOARCH or *_GOOS_GOARCH) in the same way as go/build.
+func fileNameConstraint(fileName string) (constraint.Expr, bool) {
+	name := filepath.Base(fileName)
+	if dot := strings.Index(name, "."); dot != -1 {
+		name = name[:dot]
+	}
+
+	i := strings.Index(name, "_")
+	if i < 0 {
+		return nil, false
+	}
+	name = strings.TrimSuffix(name[i:], "_test")
+
+	l := strings.Split(name, "_")
+	n := len(l)
+
+	if n >= 2 && knownOS[l[n-2]] && knownArch[l[n-1]] {
+		return &constraint.AndExpr{
+			X: &constraint.TagExpr{Tag: l[n-2]},
+			Y: &constraint.TagExpr{Tag: l[n-1]},
+		}, true
+	}
+
+	if knownOS[l[n-1]] || knownArch[l[n-1]] {
+		return &constraint.TagExpr{Tag: l[n-1]}, true
+	}
+
+	return nil, false
+}
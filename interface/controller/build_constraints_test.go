@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+)
+
+func TestBuildConstraintsOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		src      string
+		expected []string
+	}{
+		{
+			"no constraints",
+			"foo.go",
+			"package foo\n",
+			nil,
+		},
+		{
+			"go:build line",
+			"foo.go",
+			"//go:build integration\n\npackage foo\n",
+			[]string{"//go:build integration"},
+		},
+		{
+			"go:build and +build lines",
+			"foo.go",
+			"// Package foo is foo.\n\n//go:build integration\n// +build integration\n\npackage foo\n",
+			[]string{"//go:build integration", "// +build integration"},
+		},
+		{
+			"comments after package clause are ignored",
+			"foo.go",
+			"package foo\n\n//go:build integration\n",
+			nil,
+		},
+		{
+			"GOOS suffix",
+			"foo_linux.go",
+			"package foo\n",
+			[]string{"//go:build linux"},
+		},
+		{
+			"GOARCH suffix",
+			"dir/foo_amd64.go",
+			"package foo\n",
+			[]string{"//go:build amd64"},
+		},
+		{
+			"GOOS and GOARCH suffix",
+			"foo_windows_arm64.go",
+			"package foo\n",
+			[]string{"//go:build windows && arm64"},
+		},
+		{
+			"test suffix",
+			"foo_linux_test.go",
+			"package foo\n",
+			[]string{"//go:build linux"},
+		},
+		{
+			"unknown suffix",
+			"foo_bar.go",
+			"package foo\n",
+			nil,
+		},
+		{
+			"only suffix",
+			"linux.go",
+			"package foo\n",
+			nil,
+		},
+		{
+			"GOOS suffix and go:build line",
+			"foo_linux.go",
+			"//go:build integration || e2e\n\npackage foo\n",
+			[]string{"//go:build (integration || e2e) && linux"},
+		},
+		{
+			"GOOS suffix and +build lines",
+			"foo_linux.go",
+			"//go:build integration\n// +build integration\n\npackage foo\n",
+			[]string{"//go:build integration && linux", "// +build integration,linux"},
+		},
+		{
+			"GOOS suffix and only +build lines",
+			"foo_linux.go",
+			"// +build integration e2e\n// +build !386\n\npackage foo\n",
+			[]string{
+				"//go:build (integration || e2e) && !386 && linux",
+				"// +build integration e2e",
+				"// +build !386",
+				"// +build linux",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := parser.ParseFile(token.NewFileSet(), tt.fileName, tt.src, parser.ParseComments)
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+
+			actual := buildConstraintsOf(tt.fileName, f)
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("wrong value: expected %#v, got %#v", tt.expected, actual)
+			}
+		})
+	}
+}
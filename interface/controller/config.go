@@ -0,0 +1,439 @@
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFileName is the name of the configuration file, which is searched
+// from the directory of the input file to its ancestors.
+const ConfigFileName = "chapati.yaml"
+
+// context modes decide where context.Context parameters are placed in curried functions
+const (
+	contextKeep  = "keep"
+	contextFirst = "first"
+	contextLast  = "last"
+)
+
+// config is a content of the configuration file.
+//
+//	naming: "Curried{{.Name}}"
+//	context: last
+//	functions:
+//	  Add:
+//	    skip: true
+//	packages:
+//	  - pattern: "github.com/foo/*"
+//	    output:
+//	      package: curried
+//	      path: github.com/foo/curried
+//	    functions:
+//	      Query:
+//	        name: CurriedQuery
+//	        order: [db, query, ctx]
+type config struct {
+	commonConfig `yaml:",inline"`
+	Packages     []*packageConfig `yaml:"packages"`
+
+	fileName string
+}
+
+// commonConfig is a setting both for all packages and for matched packages.
+type commonConfig struct {
+	// template of curried function names
+	Naming configString `yaml:"naming"`
+	// one of "keep", "first", "last"
+	Context   configString               `yaml:"context"`
+	Output    *outputConfig              `yaml:"output"`
+	Functions map[string]*functionConfig `yaml:"functions"`
+}
+
+// packageConfig is a setting for packages whose paths match Pattern.
+type packageConfig struct {
+	Pattern      configString `yaml:"pattern"`
+	commonConfig `yaml:",inline"`
+}
+
+// outputConfig is a package where generated code is placed.
+type outputConfig struct {
+	Package configString `yaml:"package"`
+	Path    configString `yaml:"path"`
+
+	line int
+}
+
+// functionConfig is a setting for each function.
+type functionConfig struct {
+	Skip    bool         `yaml:"skip"`
+	Name    configString `yaml:"name"`
+	Order   []string     `yaml:"order"`
+	Context configString `yaml:"context"`
+
+	line int
+}
+
+// configString is a string value which remembers its line in the configuration file.
+type configString struct {
+	value string
+	line  int
+}
+
+func (s *configString) UnmarshalYAML(n *yaml.Node) error {
+	s.line = n.Line
+	return n.Decode(&s.value)
+}
+
+func (c *outputConfig) UnmarshalYAML(n *yaml.Node) error {
+	type plain outputConfig
+	c.line = n.Line
+	if err := checkKnownFields(n, plain{}); err != nil {
+		return err
+	}
+	return n.Decode((*plain)(c))
+}
+
+func (c *functionConfig) UnmarshalYAML(n *yaml.Node) error {
+	type plain functionConfig
+	c.line = n.Line
+	if err := checkKnownFields(n, plain{}); err != nil {
+		return err
+	}
+	return n.Decode((*plain)(c))
+}
+
+// checkKnownFields checks all keys in the mapping node are defined in v.
+// NOTE: n.Decode does not inherit KnownFields of the decoder
+func checkKnownFields(n *yaml.Node, v interface{}) error {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	known := map[string]bool{}
+	t := reflect.TypeOf(v)
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if tag != "" {
+			known[tag] = true
+		}
+	}
+
+	for i := 0; i < len(n.Content); i += 2 {
+		key := n.Content[i]
+		if !known[key.Value] {
+			return &yaml.TypeError{
+				Errors: []string{fmt.Sprintf("line %d: field %s not found", key.Line, key.Value)},
+			}
+		}
+	}
+
+	return nil
+}
+
+// findConfigFile searches the configuration file from dir to its ancestors.
+func findConfigFile(dir string) (string, bool, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false, err
+	}
+
+	for d := abs; ; d = filepath.Dir(d) {
+		fileName := filepath.Join(d, ConfigFileName)
+		if _, err := os.Stat(fileName); err == nil {
+			return fileName, true, nil
+		}
+
+		if filepath.Dir(d) == d {
+			return "", false, nil
+		}
+	}
+}
+
+// loadConfig reads and validates the configuration file.
+func loadConfig(fileName string) (*config, error) {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read config: %w", err)
+	}
+
+	conf := &config{fileName: fileName}
+
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	// NOTE: empty file is regarded as empty config
+	if err := dec.Decode(conf); err != nil && err != io.EOF {
+		return nil, conf.decodeError(err)
+	}
+
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+func (c *config) errorf(line int, format string, args ...interface{}) error {
+	return xerrors.Errorf("%s:%d: %s", c.fileName, line, fmt.Sprintf(format, args...))
+}
+
+var yamlErrorPattern = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// decodeError converts an error of yaml package into "file:line: message" format.
+func (c *config) decodeError(err error) error {
+	messages := []string{err.Error()}
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		messages = typeErr.Errors
+	}
+
+	converted := make([]string, len(messages))
+	for i, msg := range messages {
+		m := yamlErrorPattern.FindStringSubmatch(msg)
+		if m == nil {
+			converted[i] = fmt.Sprintf("%s: %s", c.fileName, msg)
+			continue
+		}
+		converted[i] = fmt.Sprintf("%s:%s: %s", c.fileName, m[1], m[2])
+	}
+
+	return xerrors.New(strings.Join(converted, "\n"))
+}
+
+func (c *config) validate() error {
+	if err := c.validateCommon(&c.commonConfig); err != nil {
+		return err
+	}
+
+	for _, p := range c.Packages {
+		if p.Pattern.value == "" {
+			return c.errorf(p.Pattern.line, "pattern of package must not be empty")
+		}
+
+		if _, err := path.Match(p.Pattern.value, ""); err != nil {
+			return c.errorf(p.Pattern.line, "invalid pattern %q: %v", p.Pattern.value, err)
+		}
+
+		if err := c.validateCommon(&p.commonConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *config) validateCommon(common *commonConfig) error {
+	if common.Naming.value != "" {
+		if _, err := parseNaming(common.Naming.value); err != nil {
+			return c.errorf(common.Naming.line, "invalid naming template: %v", err)
+		}
+	}
+
+	if err := c.validateContext(common.Context); err != nil {
+		return err
+	}
+
+	if o := common.Output; o != nil {
+		if o.Package.value == "" || o.Path.value == "" {
+			return c.errorf(o.line, "both package and path of output must be specified")
+		}
+	}
+
+	for _, fn := range common.Functions {
+		if fn == nil {
+			continue
+		}
+
+		if err := c.validateContext(fn.Context); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *config) validateContext(mode configString) error {
+	switch mode.value {
+	case "", contextKeep, contextFirst, contextLast:
+		return nil
+	default:
+		return c.errorf(mode.line, "context must be one of %q, %q, %q (got %q)",
+			contextKeep, contextFirst, contextLast, mode.value)
+	}
+}
+
+// settingsFor merges settings applied to the package.
+// Settings of matched packages override top-level settings in order.
+func (c *config) settingsFor(packagePath string) *settings {
+	s := &settings{
+		config:    c,
+		functions: map[string]*functionConfig{},
+	}
+	s.merge(&c.commonConfig)
+
+	for _, p := range c.Packages {
+		if matched, _ := path.Match(p.Pattern.value, packagePath); matched {
+			s.merge(&p.commonConfig)
+		}
+	}
+
+	return s
+}
+
+// settings is the configuration applied to a package.
+type settings struct {
+	*config
+	naming    configString
+	context   configString
+	output    *outputConfig
+	functions map[string]*functionConfig
+}
+
+func (s *settings) merge(common *commonConfig) {
+	if common.Naming.value != "" {
+		s.naming = common.Naming
+	}
+
+	if common.Context.value != "" {
+		s.context = common.Context
+	}
+
+	if common.Output != nil {
+		s.output = common.Output
+	}
+
+	for name, fn := range common.Functions {
+		if fn == nil {
+			continue
+		}
+
+		merged, ok := s.functions[name]
+		if !ok {
+			merged = &functionConfig{}
+			s.functions[name] = merged
+		}
+
+		merged.line = fn.line
+		merged.Skip = merged.Skip || fn.Skip
+		if fn.Name.value != "" {
+			merged.Name = fn.Name
+		}
+		if len(fn.Order) > 0 {
+			merged.Order = fn.Order
+		}
+		if fn.Context.value != "" {
+			merged.Context = fn.Context
+		}
+	}
+}
+
+// function returns the setting of the function (zero value if not configured).
+func (s *settings) function(name string) *functionConfig {
+	if fn, ok := s.functions[name]; ok {
+		return fn
+	}
+	return &functionConfig{}
+}
+
+// checkCallable returns an error if the function cannot be called from the output package.
+func (s *settings) checkCallable(funcName string) error {
+	if s.output == nil || token.IsExported(funcName) {
+		return nil
+	}
+	return s.errorf(s.output.line, "%s must be exported to generate code in package %s", funcName, s.output.Package.value)
+}
+
+// namingData is passed to the naming template.
+type namingData struct {
+	// Name is the function name whose first letter is upper case.
+	Name string
+	// FuncName is the original function name.
+	FuncName string
+	// Package is the package name.
+	Package string
+}
+
+func parseNaming(naming string) (*template.Template, error) {
+	return template.New("naming").Option("missingkey=error").Parse(naming)
+}
+
+func (s *settings) curriedFuncName(data *namingData) (string, bool, error) {
+	if s.naming.value == "" {
+		return "", false, nil
+	}
+
+	tmpl, err := parseNaming(s.naming.value)
+	if err != nil {
+		return "", false, s.errorf(s.naming.line, "invalid naming template: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", false, s.errorf(s.naming.line, "failed to execute naming template: %v", err)
+	}
+
+	return buf.String(), true, nil
+}
+
+// importPathOf returns the import path of the package in dir using go.mod.
+func importPathOf(dir string) (string, bool, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false, err
+	}
+
+	for d := abs; ; d = filepath.Dir(d) {
+		modulePath, ok, err := modulePathOf(filepath.Join(d, "go.mod"))
+		if err != nil {
+			return "", false, err
+		}
+
+		if ok {
+			rel, err := filepath.Rel(d, abs)
+			if err != nil {
+				return "", false, err
+			}
+			return path.Join(modulePath, filepath.ToSlash(rel)), true, nil
+		}
+
+		if filepath.Dir(d) == d {
+			return "", false, nil
+		}
+	}
+}
+
+func modulePathOf(goMod string) (string, bool, error) {
+	f, err := os.Open(goMod)
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), true, nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", false, err
+	}
+
+	return "", false, xerrors.Errorf("module path is not found in %s", goMod)
+}
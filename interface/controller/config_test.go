@@ -0,0 +1,213 @@
+package controller
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+)
+
+func TestLoadConfigFailed(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			"unknown field",
+			`
+			naming: "C{{.Name}}"
+			prefix: C
+			`,
+			"chapati.yaml:2: field prefix not found",
+		},
+		{
+			"unknown field in function",
+			`
+			functions:
+			  add:
+			    skip: true
+			    skipped: true
+			`,
+			"chapati.yaml:4: field skipped not found",
+		},
+		{
+			"syntax error",
+			`
+			naming: [
+			`,
+			"chapati.yaml:1: did not find expected node content",
+		},
+		{
+			"invalid naming template",
+			`
+			packages:
+			  - pattern: "example.com/*"
+			    naming: "C{{.Name"
+			`,
+			"chapati.yaml:3: invalid naming template",
+		},
+		{
+			"invalid context",
+			`
+			functions:
+			  add:
+			    context: middle
+			`,
+			`chapati.yaml:3: context must be one of "keep", "first", "last" (got "middle")`,
+		},
+		{
+			"invalid pattern",
+			`
+			packages:
+			  - pattern: "example.com/["
+			`,
+			"chapati.yaml:2: invalid pattern",
+		},
+		{
+			"incomplete output",
+			`
+			output:
+			  package: curried
+			`,
+			"chapati.yaml:2: both package and path of output must be specified",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fileName := filepath.Join(t.TempDir(), ConfigFileName)
+			content := strings.TrimPrefix(dedent.Dedent(tt.content), "\n")
+			if err := os.WriteFile(fileName, []byte(content), 0644); err != nil {
+				t.Fatalf("failed to write config: %v", err)
+			}
+
+			_, err := loadConfig(fileName)
+			if err == nil {
+				t.Fatalf("error must not be nil")
+			}
+
+			expected := filepath.Join(filepath.Dir(fileName), tt.expected)
+			if !strings.HasPrefix(err.Error(), expected) {
+				t.Errorf("wrong error: expected prefix %q, got %q", expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestConfigSettingsFor(t *testing.T) {
+	content := `
+	naming: "C{{.Name}}"
+	functions:
+	  add:
+	    order: [i2, i1]
+	packages:
+	  - pattern: "example.com/*"
+	    context: last
+	    functions:
+	      add:
+	        name: AddC
+	  - pattern: "example.com/foo"
+	    naming: "{{.Package}}{{.Name}}"
+	    functions:
+	      add:
+	        skip: true
+	`
+
+	tests := []struct {
+		name         string
+		packagePath  string
+		funcName     string
+		expectedName string
+		expected     *functionConfig
+		context      string
+	}{
+		{
+			"top-level settings",
+			"example.org/bar",
+			"add",
+			"CAdd",
+			&functionConfig{
+				Order: []string{"i2", "i1"},
+			},
+			"",
+		},
+		{
+			"package settings",
+			"example.com/bar",
+			"add",
+			"CAdd",
+			&functionConfig{
+				Name:  configString{value: "AddC"},
+				Order: []string{"i2", "i1"},
+			},
+			"last",
+		},
+		{
+			"multiple package settings",
+			"example.com/foo",
+			"add",
+			"fooAdd",
+			&functionConfig{
+				Skip:  true,
+				Name:  configString{value: "AddC"},
+				Order: []string{"i2", "i1"},
+			},
+			"last",
+		},
+		{
+			"function without settings",
+			"example.com/foo",
+			"sub",
+			"fooSub",
+			&functionConfig{},
+			"last",
+		},
+	}
+
+	fileName := filepath.Join(t.TempDir(), ConfigFileName)
+	if err := os.WriteFile(fileName, []byte(dedent.Dedent(content)), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	conf, err := loadConfig(fileName)
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := conf.settingsFor(tt.packagePath)
+
+			actual := s.function(tt.funcName)
+			// NOTE: ignore lines
+			actual.line = 0
+			actual.Name.line = 0
+			actual.Context.line = 0
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("wrong value: expected %#v, got %#v", tt.expected, actual)
+			}
+
+			if s.context.value != tt.context {
+				t.Errorf("wrong context: expected %q, got %q", tt.context, s.context.value)
+			}
+
+			name, _, err := s.curriedFuncName(&namingData{
+				Name:     strings.Title(tt.funcName),
+				FuncName: tt.funcName,
+				Package:  filepath.Base(tt.packagePath),
+			})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if name != tt.expectedName {
+				t.Errorf("wrong name: expected %q, got %q", tt.expectedName, name)
+			}
+		})
+	}
+}
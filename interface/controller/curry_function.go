@@ -1,13 +1,16 @@
 package controller
 
 import (
+	"io"
+
 	"golang.org/x/xerrors"
 
 	"github.com/syuparn/chapati/usecase"
 )
 
 type CurryFunctionController interface {
-	Handle(src string) error
+	Handle(fileName string) error
+	HandleSource(fileName string, src io.Reader) error
 }
 
 type curryFunctionController struct {
@@ -15,18 +18,74 @@ type curryFunctionController struct {
 	extracter
 }
 
+// Option is an optional setting of CurryFunctionController.
+type Option func(*extracter)
+
+// WithCurriedFuncPrefix sets the prefix of curried function names.
+func WithCurriedFuncPrefix(prefix string) Option {
+	return func(e *extracter) {
+		e.curriedFuncPrefix = prefix
+	}
+}
+
+// WithFuncNames limits functions to be curried.
+func WithFuncNames(names ...string) Option {
+	return func(e *extracter) {
+		e.funcNames = names
+	}
+}
+
+// WithPackagePath sets the import path of the package of the source code.
+func WithPackagePath(path string) Option {
+	return func(e *extracter) {
+		e.packagePath = path
+	}
+}
+
+// WithConfigFile sets the configuration file.
+func WithConfigFile(fileName string) Option {
+	return func(e *extracter) {
+		e.configFile = fileName
+	}
+}
+
+// WithConfigSearch enables to search the configuration file
+// from the directory of the source code to its ancestors.
+func WithConfigSearch() Option {
+	return func(e *extracter) {
+		e.searchConfig = true
+	}
+}
+
+// WithDeepCurrying enables to curry functions returned by functions together
+// (e.g. func(a int) func(b, c string) error is curried into func(a int) func(b string) func(c string) error).
+func WithDeepCurrying() Option {
+	return func(e *extracter) {
+		e.deep = true
+	}
+}
+
 // NewCurryFunctionController creates a new CurryFunctionController.
 func NewCurryFunctionController(
 	inputPort usecase.CurryFunctionInputPort,
+	opts ...Option,
 ) CurryFunctionController {
 	return &curryFunctionController{
 		inputPort: inputPort,
+		extracter: newExtracter(opts...),
 	}
 }
 
-// Handle generates curried function from source code.
+// Handle generates curried function from source code in the file.
 func (c *curryFunctionController) Handle(fileName string) error {
-	in, err := c.extractFuncInfo(fileName)
+	return c.HandleSource(fileName, nil)
+}
+
+// HandleSource generates curried function from source code read from src.
+// If src is nil, source code is read from the file instead.
+// fileName is used to report positions and to resolve imports.
+func (c *curryFunctionController) HandleSource(fileName string, src io.Reader) error {
+	in, err := c.extractFuncInfo(fileName, src)
 	if err != nil {
 		return xerrors.Errorf("failed to extract function from source code: %w", err)
 	}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"io"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/usecase"
+)
+
+type curryFunctionSpecController struct {
+	curryFunctionController
+}
+
+// NewCurryFunctionSpecController creates a new CurryFunctionController,
+// which reads signatures from a JSON/YAML spec instead of Go source code.
+// Options are the same as NewCurryFunctionController.
+func NewCurryFunctionSpecController(
+	inputPort usecase.CurryFunctionInputPort,
+	opts ...Option,
+) CurryFunctionController {
+	return &curryFunctionSpecController{
+		curryFunctionController: curryFunctionController{
+			inputPort: inputPort,
+			extracter: newExtracter(opts...),
+		},
+	}
+}
+
+// Handle generates curried function from the spec in the file.
+func (c *curryFunctionSpecController) Handle(fileName string) error {
+	return c.HandleSource(fileName, nil)
+}
+
+// HandleSource generates curried function from the spec read from src.
+// If src is nil, the spec is read from the file instead.
+// The format is decided by the extension of fileName (JSON if ".json", otherwise YAML).
+func (c *curryFunctionSpecController) HandleSource(fileName string, src io.Reader) error {
+	in, err := c.extractSpecInfo(fileName, src)
+	if err != nil {
+		return xerrors.Errorf("failed to extract function from spec: %w", err)
+	}
+
+	if err := c.inputPort.Exec(in); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package controller
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestNewCurryFunctionSpecController(t *testing.T) {
+	port := newMockCurryFunctionInputPort()
+
+	actual := NewCurryFunctionSpecController(port, WithCurriedFuncPrefix("C"))
+	expected := &curryFunctionSpecController{
+		curryFunctionController: curryFunctionController{
+			inputPort: port,
+			extracter: extracter{
+				curriedFuncPrefix: "C",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("wrong value: expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestCurryFunctionSpecControllerHandle(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		opts     []Option
+		expected *usecase.CurryFunctionInputData
+	}{
+		{
+			"yaml",
+			"spec.yaml",
+			[]Option{},
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "Decode",
+						CurriedFuncName: "CurriedDecode",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "r", Type: "io.Reader"},
+							{Name: "n", Type: "*gopkg.in/yaml.v3.Node"},
+						},
+						ReturnTypes: []string{
+							"error",
+						},
+						Doc: "Decode decodes YAML read from r into n.\n",
+					},
+					{
+						FuncName:        "merge",
+						CurriedFuncName: "CurriedMerge",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "nodes", Type: "map[string][]*gopkg.in/yaml.v3.Node", Incomparable: true},
+							{Name: "f", Type: "func(a, b *gopkg.in/yaml.v3.Node) (*gopkg.in/yaml.v3.Node, error)", Incomparable: true},
+						},
+						ReturnTypes: []string{
+							"*gopkg.in/yaml.v3.Node",
+						},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "decoder",
+					PackagePath: "example.com/decoder",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "gopkg.in/yaml.v3", Alias: "yaml"},
+						{Path: "io", Name: "io"},
+					},
+				},
+			},
+		},
+		{
+			"json",
+			"spec.json",
+			[]Option{},
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "add",
+						CurriedFuncName: "CurriedAdd",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "a", Type: "int"},
+							{Name: "b", Type: "int"},
+						},
+						ReturnTypes: []string{
+							"int",
+						},
+					},
+					{
+						FuncName:        "sum",
+						CurriedFuncName: "CurriedSum",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "init", Type: "int"},
+							{Name: "ns", Type: "[]int", Incomparable: true},
+						},
+						ReturnTypes: []string{
+							"int",
+						},
+						Variadic: true,
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "calc",
+					PackagePath: "calc",
+				},
+			},
+		},
+		{
+			"with options",
+			"spec.yaml",
+			[]Option{
+				WithCurriedFuncPrefix("C"),
+				WithFuncNames("merge"),
+				WithPackagePath("example.com/other"),
+			},
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "merge",
+						CurriedFuncName: "CMerge",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "nodes", Type: "map[string][]*gopkg.in/yaml.v3.Node", Incomparable: true},
+							{Name: "f", Type: "func(a, b *gopkg.in/yaml.v3.Node) (*gopkg.in/yaml.v3.Node, error)", Incomparable: true},
+						},
+						ReturnTypes: []string{
+							"*gopkg.in/yaml.v3.Node",
+						},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "decoder",
+					PackagePath: "example.com/other",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "gopkg.in/yaml.v3", Alias: "yaml"},
+						{Path: "io", Name: "io"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port := newMockCurryFunctionInputPort()
+			c := NewCurryFunctionSpecController(port, tt.opts...)
+
+			if err := c.Handle("testdata/" + tt.fileName); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if !reflect.DeepEqual(port.in, tt.expected) {
+				t.Errorf("wrong value: expected \n%#v\n, got \n%#v\n",
+					tt.expected, port.in)
+			}
+		})
+	}
+}
+
+func TestCurryFunctionSpecControllerHandleSourceFailed(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		src      string
+		expected string
+	}{
+		{
+			"unknown field",
+			"spec.yaml",
+			"package: p\nfunctions:\n  - name: f\n    returns: [int]\n",
+			"spec.yaml:4: field returns not found",
+		},
+		{
+			"unknown field in json",
+			"spec.json",
+			`{"package": "p", "funcs": []}`,
+			`unknown field "funcs"`,
+		},
+		{
+			"no package",
+			"spec.yaml",
+			"functions:\n  - name: f\n",
+			"package must not be empty",
+		},
+		{
+			"no functions",
+			"spec.yaml",
+			"package: p\n",
+			"functions must not be empty",
+		},
+		{
+			"no type",
+			"spec.yaml",
+			"package: p\nfunctions:\n  - name: f\n    params:\n      - name: a\n",
+			"both name and type of f params[0] must be specified",
+		},
+		{
+			"duplicated parameters",
+			"spec.yaml",
+			"package: p\nfunctions:\n  - name: f\n    params:\n      - {name: a, type: int}\n      - {name: a, type: int}\n",
+			"parameter a of f is duplicated",
+		},
+		{
+			"invalid type",
+			"spec.yaml",
+			"package: p\nfunctions:\n  - name: f\n    params:\n      - {name: a, type: \"map[\"}\n",
+			`invalid type "map["`,
+		},
+		{
+			"variadic parameter not at the last",
+			"spec.yaml",
+			"package: p\nfunctions:\n  - name: f\n    params:\n      - {name: a, type: ...int}\n      - {name: b, type: int}\n",
+			"only the last parameter can be variadic (got a)",
+		},
+		{
+			"unknown package",
+			"spec.yaml",
+			"package: p\nfunctions:\n  - name: f\n    params:\n      - {name: w, type: io.Writer}\n",
+			`package io in type "io.Writer" is not found in imports`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port := newMockCurryFunctionInputPort()
+			c := NewCurryFunctionSpecController(port)
+
+			err := c.HandleSource(tt.fileName, strings.NewReader(tt.src))
+			if err == nil {
+				t.Fatalf("error must not be nil")
+			}
+
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("wrong value: expected error containing %q, got %q", tt.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestIsComparableSpecType(t *testing.T) {
+	tests := []struct {
+		typ      string
+		expected bool
+	}{
+		{"int", true},
+		{"*yaml.Node", true},
+		{"[3]string", true},
+		{"struct{ a int; b *string }", true},
+		{"io.Reader", true},
+		{"[]int", false},
+		{"map[string]int", false},
+		{"func(int) error", false},
+		{"[2][]int", false},
+		{"struct{ xs []int }", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			actual := isComparableSpecType(tt.typ)
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
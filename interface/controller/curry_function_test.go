@@ -2,6 +2,7 @@ package controller
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/syuparn/chapati/usecase"
@@ -13,20 +14,44 @@ func TestNewCurryFunctionController(t *testing.T) {
 	tests := []struct {
 		name      string
 		inputPort usecase.CurryFunctionInputPort
+		opts      []Option
 		expected  CurryFunctionController
 	}{
 		{
 			"new controller",
 			port,
+			[]Option{},
 			&curryFunctionController{
 				inputPort: port,
+				extracter: extracter{
+					curriedFuncPrefix: "Curried",
+				},
+			},
+		},
+		{
+			"with options",
+			port,
+			[]Option{
+				WithCurriedFuncPrefix("C"),
+				WithFuncNames("f", "g"),
+				WithPackagePath("example.com/mypackage"),
+				WithDeepCurrying(),
+			},
+			&curryFunctionController{
+				inputPort: port,
+				extracter: extracter{
+					curriedFuncPrefix: "C",
+					funcNames:         []string{"f", "g"},
+					packagePath:       "example.com/mypackage",
+					deep:              true,
+				},
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual := NewCurryFunctionController(tt.inputPort)
+			actual := NewCurryFunctionController(tt.inputPort, tt.opts...)
 
 			if !reflect.DeepEqual(actual, tt.expected) {
 				t.Errorf("wrong value: expected %#v, got %#v", tt.expected, actual)
@@ -45,17 +70,26 @@ func TestCurryFunctionControllerHandle(t *testing.T) {
 			"with one function",
 			"simple.go",
 			&usecase.CurryFunctionInputData{
-				FuncName:        "PrintRepeat",
-				CurriedFuncName: "CurriedPrintRepeat",
-				Parameters: map[string]string{
-					"msg": "string",
-					"n":   "int",
-				},
-				ReturnTypes: []string{
-					"error",
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "PrintRepeat",
+						CurriedFuncName: "CurriedPrintRepeat",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "msg", Type: "string"},
+							{Name: "n", Type: "int"},
+						},
+						ReturnTypes: []string{
+							"error",
+						},
+						Doc: "PrintRepeat prints msg n times.\n",
+					},
 				},
 				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
 					PackageName: "test",
+					PackagePath: "test",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "fmt", Name: "fmt"},
+					},
 				},
 			},
 		},
@@ -63,15 +97,96 @@ func TestCurryFunctionControllerHandle(t *testing.T) {
 			"multiple return values",
 			"multi_return.go",
 			&usecase.CurryFunctionInputData{
-				FuncName:        "multi",
-				CurriedFuncName: "CurriedMulti",
-				Parameters:      map[string]string{},
-				ReturnTypes: []string{
-					"int",
-					"bool",
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "multi",
+						CurriedFuncName: "CurriedMulti",
+						Parameters:      []*usecase.ParameterInputData{},
+						ReturnTypes: []string{
+							"int",
+							"bool",
+						},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+				},
+			},
+		},
+		{
+			"doc comment",
+			"deprecated.go",
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "Join",
+						CurriedFuncName: "CurriedJoin",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "s1", Type: "string"},
+							{Name: "s2", Type: "string"},
+						},
+						ReturnTypes: []string{
+							"string",
+						},
+						Doc: "Join joins two strings.\n\nDeprecated: use strings.Join instead.\n",
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+				},
+			},
+		},
+		{
+			"build constraints",
+			"constrained.go",
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "sub",
+						CurriedFuncName: "CurriedSub",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "i1", Type: "int"},
+							{Name: "i2", Type: "int"},
+						},
+						ReturnTypes: []string{
+							"int",
+						},
+					},
 				},
 				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
 					PackageName: "test",
+					PackagePath: "test",
+					BuildConstraints: []string{
+						"//go:build integration",
+						"// +build integration",
+					},
+				},
+			},
+		},
+		{
+			"aliased imports",
+			"aliased.go",
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "render",
+						CurriedFuncName: "CurriedRender",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "t", Type: "*html/template.Template"},
+							{Name: "b", Type: "*strings.Builder"},
+						},
+						ReturnTypes: []string{},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "html/template", Name: "template", Alias: "htemplate"},
+						{Path: "strings", Name: "strings", Alias: "."},
+					},
 				},
 			},
 		},
@@ -79,17 +194,22 @@ func TestCurryFunctionControllerHandle(t *testing.T) {
 			"compound types",
 			"compound.go",
 			&usecase.CurryFunctionInputData{
-				FuncName:        "handleCompound",
-				CurriedFuncName: "CurriedHandleCompound",
-				Parameters: map[string]string{
-					"ptrArg":  "*string",
-					"mapArg":  "map[string]interface{}",
-					"arrArg":  "[]int",
-					"funcArg": "func(int) string",
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "handleCompound",
+						CurriedFuncName: "CurriedHandleCompound",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "ptrArg", Type: "*string"},
+							{Name: "mapArg", Type: "map[string]interface{}", Incomparable: true},
+							{Name: "arrArg", Type: "[]int", Incomparable: true},
+							{Name: "funcArg", Type: "func(int) string", Incomparable: true},
+						},
+						ReturnTypes: []string{},
+					},
 				},
-				ReturnTypes: []string{},
 				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
 					PackageName: "test",
+					PackagePath: "test",
 				},
 			},
 		},
@@ -97,14 +217,22 @@ func TestCurryFunctionControllerHandle(t *testing.T) {
 			"defined type",
 			"defined.go",
 			&usecase.CurryFunctionInputData{
-				FuncName:        "hello",
-				CurriedFuncName: "CurriedHello",
-				Parameters: map[string]string{
-					"person": "test.Person",
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "hello",
+						CurriedFuncName: "CurriedHello",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "person", Type: "test.Person"},
+						},
+						ReturnTypes: []string{},
+					},
 				},
-				ReturnTypes: []string{},
 				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
 					PackageName: "test",
+					PackagePath: "test",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "fmt", Name: "fmt"},
+					},
 				},
 			},
 		},
@@ -112,14 +240,107 @@ func TestCurryFunctionControllerHandle(t *testing.T) {
 			"imported type",
 			"imported.go",
 			&usecase.CurryFunctionInputData{
-				FuncName:        "write",
-				CurriedFuncName: "CurriedWrite",
-				Parameters: map[string]string{
-					"w": "io.Writer",
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "write",
+						CurriedFuncName: "CurriedWrite",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "w", Type: "io.Writer"},
+						},
+						ReturnTypes: []string{},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "io", Name: "io"},
+					},
+				},
+			},
+		},
+		{
+			"variadic",
+			"variadic.go",
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "joinAll",
+						CurriedFuncName: "CurriedJoinAll",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "sep", Type: "string"},
+							{Name: "elems", Type: "[]string", Incomparable: true},
+						},
+						ReturnTypes: []string{
+							"string",
+						},
+						Variadic: true,
+					},
 				},
-				ReturnTypes: []string{},
 				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
 					PackageName: "test",
+					PackagePath: "test",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "strings", Name: "strings"},
+					},
+				},
+			},
+		},
+		{
+			"unnamed and blank parameters",
+			"unnamed.go",
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "check",
+						CurriedFuncName: "CurriedCheck",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "p0", Type: "int"},
+							{Name: "p1", Type: "string"},
+						},
+						ReturnTypes: []string{
+							"error",
+						},
+					},
+					{
+						FuncName:        "ignore",
+						CurriedFuncName: "CurriedIgnore",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "p01", Type: "int"},
+							{Name: "p0", Type: "string"},
+							{Name: "p2", Type: "bool"},
+						},
+						ReturnTypes: []string{
+							"int",
+						},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+				},
+			},
+		},
+		{
+			"generic functions are skipped",
+			"generic.go",
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "pickInt",
+						CurriedFuncName: "CurriedPickInt",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "a", Type: "int"},
+							{Name: "b", Type: "int"},
+						},
+						ReturnTypes: []string{
+							"int",
+						},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
 				},
 			},
 		},
@@ -127,14 +348,22 @@ func TestCurryFunctionControllerHandle(t *testing.T) {
 			"imported third-party type",
 			"imported_thirdparty.go",
 			&usecase.CurryFunctionInputData{
-				FuncName:        "handleCode",
-				CurriedFuncName: "CurriedHandleCode",
-				Parameters: map[string]string{
-					"c": "github.com/dave/jennifer/jen.Code",
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "handleCode",
+						CurriedFuncName: "CurriedHandleCode",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "c", Type: "github.com/dave/jennifer/jen.Code"},
+						},
+						ReturnTypes: []string{},
+					},
 				},
-				ReturnTypes: []string{},
 				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
 					PackageName: "test",
+					PackagePath: "test",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "github.com/dave/jennifer/jen", Name: "jen"},
+					},
 				},
 			},
 		},
@@ -157,6 +386,295 @@ func TestCurryFunctionControllerHandle(t *testing.T) {
 	}
 }
 
+func TestCurryFunctionControllerHandleWithOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		opts     []Option
+		expected *usecase.CurryFunctionInputData
+	}{
+		{
+			"multiple functions",
+			"multi_funcs.go",
+			[]Option{},
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "move",
+						CurriedFuncName: "CurriedMove",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "p", Type: "test.Point"},
+							{Name: "dx", Type: "int"},
+							{Name: "dy", Type: "int"},
+						},
+						ReturnTypes: []string{
+							"test.Point",
+						},
+					},
+					{
+						FuncName:        "add",
+						CurriedFuncName: "CurriedAdd",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "i1", Type: "int"},
+							{Name: "i2", Type: "int"},
+						},
+						ReturnTypes: []string{
+							"int",
+						},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+				},
+			},
+		},
+		{
+			"with config",
+			"config/config.go",
+			[]Option{
+				WithConfigSearch(),
+			},
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "Query",
+						CurriedFuncName: "CurriedQuery",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "ctx", Type: "context.Context"},
+							{Name: "table", Type: "string"},
+							{Name: "limit", Type: "int"},
+						},
+						ReturnTypes: []string{
+							"[]string",
+							"error",
+						},
+						ArgumentOrder: []string{"limit", "table", "ctx"},
+					},
+					{
+						FuncName:        "Fetch",
+						CurriedFuncName: "FetchC",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "ctx", Type: "context.Context"},
+							{Name: "url", Type: "string"},
+						},
+						ReturnTypes: []string{
+							"error",
+						},
+						ArgumentOrder: []string{"url", "ctx"},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName:       "test",
+					PackagePath:       "github.com/syuparn/chapati/onlyfortestdata/test/config",
+					OutputPackageName: "curried",
+					OutputPackagePath: "github.com/syuparn/chapati/onlyfortestdata/test/curried",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "context", Name: "context"},
+					},
+				},
+			},
+		},
+		{
+			"with options",
+			"multi_funcs.go",
+			[]Option{
+				WithCurriedFuncPrefix("C"),
+				WithFuncNames("move"),
+				WithPackagePath("example.com/test"),
+			},
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "move",
+						CurriedFuncName: "CMove",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "p", Type: "example.com/test.Point"},
+							{Name: "dx", Type: "int"},
+							{Name: "dy", Type: "int"},
+						},
+						ReturnTypes: []string{
+							"example.com/test.Point",
+						},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "example.com/test",
+				},
+			},
+		},
+		{
+			"returned functions are not curried by default",
+			"returned_func.go",
+			[]Option{
+				WithFuncNames("compile"),
+			},
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "compile",
+						CurriedFuncName: "CurriedCompile",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "a", Type: "int"},
+						},
+						ReturnTypes: []string{
+							"func(b string, c string) error",
+						},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+				},
+			},
+		},
+		{
+			"with deep currying",
+			"returned_func.go",
+			[]Option{
+				WithDeepCurrying(),
+			},
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "compile",
+						CurriedFuncName: "CurriedCompile",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "a", Type: "int"},
+							{Name: "b", Type: "string"},
+							{Name: "c", Type: "string"},
+						},
+						ReturnTypes: []string{
+							"error",
+						},
+						CallArities: []int{1, 2},
+					},
+					{
+						FuncName:        "nest",
+						CurriedFuncName: "CurriedNest",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "a", Type: "int"},
+							{Name: "p1", Type: "string"},
+							{Name: "a1", Type: "string"},
+							{Name: "xs", Type: "[]int", Incomparable: true},
+						},
+						ReturnTypes: []string{
+							"int",
+						},
+						Variadic:    true,
+						CallArities: []int{1, 1, 2},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port := newMockCurryFunctionInputPort()
+			c := NewCurryFunctionController(port, tt.opts...)
+
+			if err := c.Handle("testdata/" + tt.fileName); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if !reflect.DeepEqual(port.in, tt.expected) {
+				t.Errorf("wrong value: expected \n%#v\n, got \n%#v\n",
+					tt.expected, port.in)
+			}
+		})
+	}
+}
+
+func TestCurryFunctionControllerHandleSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		src      string
+		expected *usecase.CurryFunctionInputData
+	}{
+		{
+			"read from src",
+			"stdin.go",
+			`package test
+
+func concat(s1 string, s2 string) string {
+	return s1 + s2
+}
+`,
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "concat",
+						CurriedFuncName: "CurriedConcat",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "s1", Type: "string"},
+							{Name: "s2", Type: "string"},
+						},
+						ReturnTypes: []string{
+							"string",
+						},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+				},
+			},
+		},
+		{
+			"src is prior to the file",
+			"testdata/simple.go",
+			`package test
+
+import "io"
+
+func write(w io.Writer) {}
+`,
+			&usecase.CurryFunctionInputData{
+				Functions: []*usecase.FunctionInputData{
+					{
+						FuncName:        "write",
+						CurriedFuncName: "CurriedWrite",
+						Parameters: []*usecase.ParameterInputData{
+							{Name: "w", Type: "io.Writer"},
+						},
+						ReturnTypes: []string{},
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "test",
+					PackagePath: "test",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "io", Name: "io"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port := newMockCurryFunctionInputPort()
+			c := NewCurryFunctionController(port)
+
+			if err := c.HandleSource(tt.fileName, strings.NewReader(tt.src)); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if !reflect.DeepEqual(port.in, tt.expected) {
+				t.Errorf("wrong value: expected \n%#v\n, got \n%#v\n",
+					tt.expected, port.in)
+			}
+		})
+	}
+}
+
 func TestCurryFunctionControllerHandleFailed(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1,34 +1,69 @@
 package controller
 
 import (
+	"fmt"
 	"go/ast"
 	"go/importer"
 	"go/parser"
 	"go/token"
 	"go/types"
+	"io"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/syuparn/chapati/usecase"
 	"golang.org/x/xerrors"
 )
 
-// TODO: enable to set from config
-const curriedFuncPrefix = "Curried"
+// DefaultCurriedFuncPrefix is prepended to the name of each curried function by default.
+const DefaultCurriedFuncPrefix = "Curried"
 
-type extracter struct{}
+type extracter struct {
+	// prefix of curried function names
+	curriedFuncPrefix string
+	// names of functions to be extracted (all functions are extracted if empty)
+	funcNames []string
+	// import path of the package (package name is used if empty)
+	packagePath string
+	// configuration file (searched from the directory of the source if empty)
+	configFile string
+	// whether configuration file is searched or not
+	searchConfig bool
+	// whether functions returned by functions are also curried
+	deep bool
+}
+
+func newExtracter(opts ...Option) extracter {
+	e := extracter{
+		curriedFuncPrefix: DefaultCurriedFuncPrefix,
+	}
+
+	for _, opt := range opts {
+		opt(&e)
+	}
+
+	return e
+}
 
 func (e extracter) extractFuncInfo(
 	fileName string,
+	src io.Reader,
 ) (*usecase.CurryFunctionInputData, error) {
 	fset := token.NewFileSet()
-	conf := types.Config{
+	typesConf := types.Config{
 		// NOTE: use "source" to import directly from source
 		// ("cg" cannot work if dependent package is not installed globally)
 		Importer: importer.ForCompiler(fset, "source", nil),
 	}
 
-	//                                         src, mode
-	f, err := parser.ParseFile(fset, fileName, nil, parser.ParseComments)
+	// NOTE: if src is nil, source code is read from fileName
+	var code interface{}
+	if src != nil {
+		code = src
+	}
+
+	f, err := parser.ParseFile(fset, fileName, code, parser.ParseComments)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to parse code: %w", err)
 	}
@@ -38,24 +73,158 @@ func (e extracter) extractFuncInfo(
 	}
 	packageName := f.Name.Name
 
+	conf, err := e.config(fileName)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load config: %w", err)
+	}
+
+	packagePath, err := e.packagePathOf(fileName, packageName, conf)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to resolve package path: %w", err)
+	}
+	settings := conf.settingsFor(packagePath)
+
 	info := &types.Info{
 		Defs: map[*ast.Ident]types.Object{},
 	}
 
-	_, err = conf.Check(packageName, fset, []*ast.File{f}, info)
+	pkg, err := typesConf.Check(packagePath, fset, []*ast.File{f}, info)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to extract info: %w", err)
 	}
 
-	for ident := range info.Defs {
-		// TODO: return multiple functions
-		funcType, ok := e.funcTypeOf(info, ident)
+	functions := []*usecase.FunctionInputData{}
+
+	// NOTE: iterate declarations to keep the order in source code
+	for _, decl := range f.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || !e.isTarget(funcDecl.Name.Name) || settings.function(funcDecl.Name.Name).Skip {
+			continue
+		}
+
+		funcType, ok := e.funcTypeOf(info, funcDecl.Name)
+		if !ok {
+			continue
+		}
+
+		// NOTE: curried functions in another package can only call exported functions
+		if err := settings.checkCallable(funcDecl.Name.Name); err != nil {
+			return nil, err
+		}
+
+		fn, err := e.functionDataFrom(funcDecl.Name.Name, funcType, packageName, settings)
+		if err != nil {
+			return nil, err
+		}
+		fn.Doc = funcDecl.Doc.Text()
+		functions = append(functions, fn)
+	}
+
+	if len(functions) == 0 {
+		return nil, xerrors.Errorf("no functions found in soruce code")
+	}
+
+	meta := usecase.CurriedFunctionMetaData{
+		PackageName:      packageName,
+		PackagePath:      packagePath,
+		BuildConstraints: buildConstraintsOf(fileName, f),
+		Imports:          importsOf(f, pkg),
+	}
+	if settings.output != nil {
+		meta.OutputPackageName = settings.output.Package.value
+		meta.OutputPackagePath = settings.output.Path.value
+	}
+
+	return &usecase.CurryFunctionInputData{
+		Functions:               functions,
+		CurriedFunctionMetaData: meta,
+	}, nil
+}
+
+// importsOf returns packages imported by the source file with their aliases.
+func importsOf(f *ast.File, pkg *types.Package) []*usecase.ImportMetaData {
+	names := map[string]string{}
+	for _, imported := range pkg.Imports() {
+		names[imported.Path()] = imported.Name()
+	}
+
+	imports := []*usecase.ImportMetaData{}
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		imp := &usecase.ImportMetaData{
+			Path: importPath,
+			Name: names[importPath],
+		}
+		if spec.Name != nil {
+			imp.Alias = spec.Name.Name
+		}
+
+		imports = append(imports, imp)
+	}
+
+	if len(imports) == 0 {
+		return nil
+	}
+	return imports
+}
+
+// config loads the configuration file (empty config if not found).
+func (e extracter) config(fileName string) (*config, error) {
+	configFile := e.configFile
+
+	if configFile == "" && e.searchConfig {
+		found, ok, err := findConfigFile(filepath.Dir(fileName))
+		if err != nil {
+			return nil, err
+		}
 		if ok {
-			return e.inputDataFrom(ident.Name, funcType, packageName), nil
+			configFile = found
 		}
 	}
 
-	return nil, xerrors.Errorf("no functions found in soruce code")
+	if configFile == "" {
+		return &config{}, nil
+	}
+
+	return loadConfig(configFile)
+}
+
+// packagePathOf returns the import path of the source.
+// If a configuration file is used, the path is resolved by go.mod.
+func (e extracter) packagePathOf(fileName, packageName string, conf *config) (string, error) {
+	if e.packagePath != "" {
+		return e.packagePath, nil
+	}
+
+	if conf.fileName != "" {
+		importPath, ok, err := importPathOf(filepath.Dir(fileName))
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return importPath, nil
+		}
+	}
+
+	return packageName, nil
+}
+
+func (e extracter) isTarget(funcName string) bool {
+	if len(e.funcNames) == 0 {
+		return true
+	}
+
+	for _, name := range e.funcNames {
+		if name == funcName {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (e extracter) funcTypeOf(
@@ -77,34 +246,253 @@ func (e extracter) funcTypeOf(
 		return nil, false
 	}
 
+	// TODO: enable to use generic function
+	// (type parameters cannot be referred in curried functions for now)
+	if t.TypeParams().Len() > 0 {
+		return nil, false
+	}
+
 	return t, true
 }
 
-func (e extracter) inputDataFrom(
+func (e extracter) functionDataFrom(
 	funcName string,
 	t *types.Signature,
 	packageName string,
-) *usecase.CurryFunctionInputData {
-	params := map[string]string{}
-	returnTypes := make([]string, t.Results().Len())
+	settings *settings,
+) (*usecase.FunctionInputData, error) {
+	params := make([]*usecase.ParameterInputData, t.Params().Len())
+
+	used := map[string]bool{}
+	for i := 0; i < t.Params().Len(); i++ {
+		used[t.Params().At(i).Name()] = true
+	}
 
 	for i := 0; i < t.Params().Len(); i++ {
 		p := t.Params().At(i)
-		params[p.Name()] = p.Type().String()
+		name := p.Name()
+		// NOTE: unnamed and blank parameters cannot be referred in curried functions
+		if name == "" || name == "_" {
+			name = uniqueParamName(name, i, used)
+			used[name] = true
+		}
+		params[i] = parameterDataFrom(p, name)
+	}
+
+	// NOTE: the function returning results is the last one called in deep currying
+	last := t
+	var arities []int
+	if e.deep {
+		params, last, arities = flattenReturnedFuncs(t, params)
 	}
 
-	for i := 0; i < t.Results().Len(); i++ {
-		p := t.Results().At(i)
+	returnTypes := make([]string, last.Results().Len())
+	for i := 0; i < last.Results().Len(); i++ {
+		p := last.Results().At(i)
 		returnTypes[i] = p.Type().String()
 	}
 
-	return &usecase.CurryFunctionInputData{
+	curriedFuncName, err := e.curriedFuncNameOf(funcName, packageName, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := e.argumentOrderOf(funcName, params, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.FunctionInputData{
 		FuncName:        funcName,
-		CurriedFuncName: curriedFuncPrefix + strings.Title(funcName),
+		CurriedFuncName: curriedFuncName,
 		Parameters:      params,
 		ReturnTypes:     returnTypes,
-		CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
-			PackageName: packageName,
-		},
+		ArgumentOrder:   order,
+		Variadic:        last.Variadic(),
+		CallArities:     arities,
+	}, nil
+}
+
+func parameterDataFrom(p *types.Var, name string) *usecase.ParameterInputData {
+	return &usecase.ParameterInputData{
+		Name:         name,
+		Type:         p.Type().String(),
+		Incomparable: !types.Comparable(p.Type()),
+	}
+}
+
+// flattenReturnedFuncs appends parameters of functions returned by t to params for deep currying.
+// The last function called and numbers of parameters of each call are also returned
+// (arities is nil if t does not return a function).
+func flattenReturnedFuncs(
+	t *types.Signature,
+	params []*usecase.ParameterInputData,
+) ([]*usecase.ParameterInputData, *types.Signature, []int) {
+	used := map[string]bool{}
+	for _, p := range params {
+		used[p.Name] = true
+	}
+
+	arities := []int{len(params)}
+	last := t
+	// NOTE: variadic parameters must be the last ones
+	for !last.Variadic() {
+		next, ok := returnedFuncOf(last)
+		if !ok {
+			break
+		}
+
+		for i := 0; i < next.Params().Len(); i++ {
+			p := next.Params().At(i)
+			name := uniqueParamName(p.Name(), len(params), used)
+			used[name] = true
+			params = append(params, parameterDataFrom(p, name))
+		}
+
+		arities = append(arities, next.Params().Len())
+		last = next
+	}
+
+	if last == t {
+		return params, t, nil
+	}
+	return params, last, arities
+}
+
+// returnedFuncOf returns the type of the function returned by t if it returns only a function.
+// Named function types are not followed.
+func returnedFuncOf(t *types.Signature) (*types.Signature, bool) {
+	if t.Results().Len() != 1 {
+		return nil, false
+	}
+
+	sig, ok := t.Results().At(0).Type().(*types.Signature)
+	return sig, ok
+}
+
+// uniqueParamName names the i-th parameter so that it does not conflict with used names
+// (unnamed and blank parameters are named p{i}).
+func uniqueParamName(name string, i int, used map[string]bool) string {
+	if name == "" || name == "_" {
+		name = fmt.Sprintf("p%d", i)
+	}
+
+	unique := name
+	for n := 1; used[unique]; n++ {
+		unique = fmt.Sprintf("%s%d", name, n)
+	}
+	return unique
+}
+
+func (e extracter) curriedFuncNameOf(
+	funcName string,
+	packageName string,
+	settings *settings,
+) (string, error) {
+	if name := settings.function(funcName).Name.value; name != "" {
+		return name, nil
+	}
+
+	name, ok, err := settings.curriedFuncName(&namingData{
+		Name:     strings.Title(funcName),
+		FuncName: funcName,
+		Package:  packageName,
+	})
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return name, nil
+	}
+
+	return e.curriedFuncPrefix + strings.Title(funcName), nil
+}
+
+// argumentOrderOf returns parameter names in the curried order.
+// nil is returned if the order is not changed.
+func (e extracter) argumentOrderOf(
+	funcName string,
+	params []*usecase.ParameterInputData,
+	settings *settings,
+) ([]string, error) {
+	fnConf := settings.function(funcName)
+
+	order := make([]string, len(params))
+	for i, p := range params {
+		order[i] = p.Name
+	}
+
+	if len(fnConf.Order) > 0 {
+		if !isPermutation(fnConf.Order, order) {
+			return nil, settings.errorf(fnConf.line, "order of %s must consist of all parameters %v (got %v)",
+				funcName, order, fnConf.Order)
+		}
+		order = fnConf.Order
+	}
+
+	mode := settings.context.value
+	if fnConf.Context.value != "" {
+		mode = fnConf.Context.value
+	}
+	order = moveContextParams(order, params, mode)
+
+	for i, p := range params {
+		if order[i] != p.Name {
+			return order, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func isPermutation(names []string, expected []string) bool {
+	if len(names) != len(expected) {
+		return false
+	}
+
+	counts := map[string]int{}
+	for _, name := range expected {
+		counts[name]++
+	}
+
+	for _, name := range names {
+		if counts[name] == 0 {
+			return false
+		}
+		counts[name]--
+	}
+
+	return true
+}
+
+func moveContextParams(
+	order []string,
+	params []*usecase.ParameterInputData,
+	mode string,
+) []string {
+	if mode != contextFirst && mode != contextLast {
+		return order
+	}
+
+	isContext := map[string]bool{}
+	for _, p := range params {
+		if p.Type == "context.Context" {
+			isContext[p.Name] = true
+		}
+	}
+
+	contexts := []string{}
+	others := []string{}
+	for _, name := range order {
+		if isContext[name] {
+			contexts = append(contexts, name)
+		} else {
+			others = append(others, name)
+		}
+	}
+
+	if mode == contextFirst {
+		return append(contexts, others...)
 	}
+	return append(others, contexts...)
 }
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// OutputDir returns the directory of the package where code generated from the file is placed
+// if the configuration generates it in another package (false otherwise).
+// The directory is resolved from the import path of the output package by go.mod.
+func OutputDir(fileName string, opts ...Option) (string, bool, error) {
+	return newExtracter(opts...).outputDirOf(fileName)
+}
+
+func (e extracter) outputDirOf(fileName string) (string, bool, error) {
+	conf, err := e.config(fileName)
+	if err != nil {
+		return "", false, xerrors.Errorf("failed to load config: %w", err)
+	}
+
+	packagePath, err := e.sourcePackagePathOf(fileName, conf)
+	if err != nil {
+		return "", false, xerrors.Errorf("failed to resolve package path: %w", err)
+	}
+
+	settings := conf.settingsFor(packagePath)
+	if settings.output == nil || settings.output.Path.value == packagePath {
+		return "", false, nil
+	}
+
+	dir, err := packageDirOf(filepath.Dir(fileName), settings.output.Path.value)
+	if err != nil {
+		return "", false, settings.errorf(settings.output.line, "failed to resolve output path: %v", err)
+	}
+
+	return dir, true, nil
+}
+
+// sourcePackagePathOf returns the import path of the source without type-checking it.
+func (e extracter) sourcePackagePathOf(fileName string, conf *config) (string, error) {
+	if IsSpecFile(fileName) {
+		s, err := decodeSpec(fileName, nil)
+		if err != nil {
+			return "", xerrors.Errorf("failed to decode spec: %w", err)
+		}
+
+		// NOTE: same as extractSpecInfo
+		if e.packagePath != "" {
+			return e.packagePath, nil
+		}
+		if s.Path != "" {
+			return s.Path, nil
+		}
+		return s.Package, nil
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), fileName, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return "", xerrors.Errorf("failed to parse code: %w", err)
+	}
+
+	return e.packagePathOf(fileName, f.Name.Name, conf)
+}
+
+// packageDirOf returns the directory of the package in the module containing dir.
+func packageDirOf(dir, importPath string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for d := abs; ; d = filepath.Dir(d) {
+		modulePath, ok, err := modulePathOf(filepath.Join(d, "go.mod"))
+		if err != nil {
+			return "", err
+		}
+
+		if ok {
+			if importPath == modulePath {
+				return d, nil
+			}
+			if !strings.HasPrefix(importPath, modulePath+"/") {
+				return "", xerrors.Errorf("%s is not in module %s", importPath, modulePath)
+			}
+			return filepath.Join(d, filepath.FromSlash(strings.TrimPrefix(importPath, modulePath+"/"))), nil
+		}
+
+		if filepath.Dir(d) == d {
+			return "", xerrors.Errorf("go.mod is not found")
+		}
+	}
+}
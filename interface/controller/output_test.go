@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOutputDir(t *testing.T) {
+	tests := []struct {
+		name       string
+		fileName   string
+		opts       []Option
+		expected   string
+		configured bool
+	}{
+		{
+			"output is configured",
+			"testdata/config/config.go",
+			[]Option{WithConfigSearch()},
+			"testdata/curried",
+			true,
+		},
+		{
+			"config is not used",
+			"testdata/config/config.go",
+			[]Option{},
+			"",
+			false,
+		},
+		{
+			"output is not configured",
+			"testdata/simple.go",
+			[]Option{WithConfigSearch()},
+			"",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, configured, err := OutputDir(tt.fileName, tt.opts...)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if configured != tt.configured {
+				t.Fatalf("wrong value: expected %v, got %v", tt.configured, configured)
+			}
+
+			expected := tt.expected
+			if expected != "" {
+				expected, _ = filepath.Abs(expected)
+			}
+			if actual != expected {
+				t.Errorf("wrong value: expected %s, got %s", expected, actual)
+			}
+		})
+	}
+}
+
+func TestOutputDirInAnotherDirectory(t *testing.T) {
+	tests := []struct {
+		name       string
+		outputPath string
+		fileName   string
+		configFile bool
+		expected   string
+	}{
+		{
+			"nested directory",
+			"example.com/t3/gen/curried",
+			"src/s.go",
+			false,
+			"gen/curried",
+		},
+		{
+			"module root",
+			"example.com/t3",
+			"src/s.go",
+			false,
+			".",
+		},
+		{
+			"config file specified",
+			"example.com/t3/gen/curried",
+			"src/s.go",
+			true,
+			"gen/curried",
+		},
+		{
+			"spec file",
+			"example.com/t3/gen/curried",
+			"src/spec.yaml",
+			false,
+			"gen/curried",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeTestFiles(t, dir, map[string]string{
+				"go.mod": "module example.com/t3\n",
+				ConfigFileName: `
+packages:
+  - pattern: "example.com/t3/*"
+    output:
+      package: curried
+      path: ` + tt.outputPath + `
+`,
+				"src/s.go":      "package src\n\nfunc Add(i1, i2 int) int {\n\treturn i1 + i2\n}\n",
+				"src/spec.yaml": "package: src\npath: example.com/t3/src\nfunctions:\n  - name: Add\n",
+			})
+
+			opt := WithConfigSearch()
+			if tt.configFile {
+				opt = WithConfigFile(filepath.Join(dir, ConfigFileName))
+			}
+
+			actual, configured, err := OutputDir(filepath.Join(dir, tt.fileName), opt)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if !configured {
+				t.Fatalf("output must be configured")
+			}
+
+			expected := filepath.Join(dir, tt.expected)
+			if actual != expected {
+				t.Errorf("wrong value: expected %s, got %s", expected, actual)
+			}
+		})
+	}
+}
+
+func TestOutputDirFailed(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"go.mod": "module example.com/t3\n",
+		ConfigFileName: `
+packages:
+  - pattern: "example.com/t3/*"
+    output:
+      package: curried
+      path: example.com/other/curried
+`,
+		"src/s.go": "package src\n\nfunc Add(i1, i2 int) int {\n\treturn i1 + i2\n}\n",
+	})
+
+	_, _, err := OutputDir(filepath.Join(dir, "src/s.go"), WithConfigSearch())
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+
+	expected := "chapati.yaml:4: failed to resolve output path: example.com/other/curried is not in module example.com/t3"
+	if !strings.Contains(err.Error(), expected) {
+		t.Errorf("wrong error: expected to contain %q, got %q", expected, err.Error())
+	}
+}
+
+func TestCurryFunctionControllerHandleUnexportedWithOutput(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"go.mod": "module example.com/t3\n",
+		ConfigFileName: `
+packages:
+  - pattern: "example.com/t3/*"
+    output:
+      package: curried
+      path: example.com/t3/curried
+`,
+		"src/s.go": "package src\n\nfunc add(i1, i2 int) int {\n\treturn i1 + i2\n}\n",
+	})
+
+	port := newMockCurryFunctionInputPort()
+	c := NewCurryFunctionController(port, WithConfigSearch())
+
+	err := c.Handle(filepath.Join(dir, "src/s.go"))
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+
+	expected := "chapati.yaml:4: add must be exported to generate code in package curried"
+	if !strings.Contains(err.Error(), expected) {
+		t.Errorf("wrong error: expected to contain %q, got %q", expected, err.Error())
+	}
+}
+
+func writeTestFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(strings.TrimPrefix(content, "\n")), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,341 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/types"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/syuparn/chapati/usecase"
+)
+
+// spec is a description of functions to be curried, which is used instead of Go source code.
+//
+//	package: example
+//	path: github.com/you/example
+//	imports:
+//	  io: io
+//	  yaml: gopkg.in/yaml.v3
+//	functions:
+//	  - name: Decode
+//	    params:
+//	      - {name: r, type: io.Reader}
+//	      - {name: n, type: "*yaml.Node"}
+//	    results: [error]
+type spec struct {
+	// Package is the package name of the functions.
+	Package string `json:"package" yaml:"package"`
+	// Path is the import path of the package (optional).
+	Path string `json:"path" yaml:"path"`
+	// Imports maps package names used in types to import paths.
+	Imports   map[string]string `json:"imports" yaml:"imports"`
+	Functions []*functionSpec   `json:"functions" yaml:"functions"`
+}
+
+type functionSpec struct {
+	Name    string       `json:"name" yaml:"name"`
+	Doc     string       `json:"doc" yaml:"doc"`
+	Params  []*paramSpec `json:"params" yaml:"params"`
+	Results []string     `json:"results" yaml:"results"`
+}
+
+type paramSpec struct {
+	Name string `json:"name" yaml:"name"`
+	Type string `json:"type" yaml:"type"`
+}
+
+// IsSpecFile reports whether the file is a spec (JSON or YAML) instead of Go source code.
+func IsSpecFile(fileName string) bool {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// decodeSpec decodes a spec in JSON (if the extension is ".json") or YAML.
+func decodeSpec(fileName string, src io.Reader) (*spec, error) {
+	if src == nil {
+		f, err := os.Open(fileName)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read spec: %w", err)
+		}
+		defer f.Close()
+		src = f
+	}
+
+	b, err := io.ReadAll(src)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read spec: %w", err)
+	}
+
+	s := &spec{}
+
+	if strings.ToLower(filepath.Ext(fileName)) == ".json" {
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(s); err != nil {
+			return nil, xerrors.Errorf("%s: %w", fileName, err)
+		}
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(b))
+		dec.KnownFields(true)
+		if err := dec.Decode(s); err != nil && err != io.EOF {
+			return nil, (&config{fileName: fileName}).decodeError(err)
+		}
+	}
+
+	if err := s.validate(); err != nil {
+		return nil, xerrors.Errorf("%s: %w", fileName, err)
+	}
+
+	return s, nil
+}
+
+func (s *spec) validate() error {
+	if s.Package == "" {
+		return xerrors.Errorf("package must not be empty")
+	}
+
+	if len(s.Functions) == 0 {
+		return xerrors.Errorf("functions must not be empty")
+	}
+
+	for i, fn := range s.Functions {
+		if fn == nil || fn.Name == "" {
+			return xerrors.Errorf("name of functions[%d] must not be empty", i)
+		}
+
+		names := map[string]bool{}
+		for j, p := range fn.Params {
+			if p == nil || p.Name == "" || p.Type == "" {
+				return xerrors.Errorf("both name and type of %s params[%d] must be specified", fn.Name, j)
+			}
+			if names[p.Name] {
+				return xerrors.Errorf("parameter %s of %s is duplicated", p.Name, fn.Name)
+			}
+			names[p.Name] = true
+		}
+	}
+
+	return nil
+}
+
+// qualifiedType converts package names in t into import paths
+// in the same format as go/types (e.g. "map[string]*yaml.Node" -> "map[string]*gopkg.in/yaml.v3.Node").
+func (s *spec) qualifiedType(t string) (string, error) {
+	expr, err := parser.ParseExpr(t)
+	if err != nil {
+		return "", xerrors.Errorf("invalid type %q: %w", t, err)
+	}
+
+	var inspectErr error
+	ast.Inspect(expr, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		ident, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		importPath, ok := s.Imports[ident.Name]
+		if !ok {
+			inspectErr = xerrors.Errorf("package %s in type %q is not found in imports", ident.Name, t)
+			return false
+		}
+
+		// NOTE: import paths are printed as they are
+		ident.Name = importPath
+		return false
+	})
+	if inspectErr != nil {
+		return "", inspectErr
+	}
+
+	return types.ExprString(expr), nil
+}
+
+// isComparableSpecType reports whether values of the type t can be compared
+// (named types are assumed to be comparable because their definitions are unknown).
+func isComparableSpecType(t string) bool {
+	expr, err := parser.ParseExpr(t)
+	if err != nil {
+		return false
+	}
+	return isComparableTypeExpr(expr)
+}
+
+func isComparableTypeExpr(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.ArrayType:
+		// NOTE: slices do not have lengths
+		return e.Len != nil && isComparableTypeExpr(e.Elt)
+	case *ast.MapType, *ast.FuncType:
+		return false
+	case *ast.StructType:
+		for _, field := range e.Fields.List {
+			if !isComparableTypeExpr(field.Type) {
+				return false
+			}
+		}
+		return true
+	case *ast.ParenExpr:
+		return isComparableTypeExpr(e.X)
+	default:
+		return true
+	}
+}
+
+// importsMetaData converts imports into usecase.ImportMetaData sorted by paths.
+func (s *spec) importsMetaData() []*usecase.ImportMetaData {
+	imports := []*usecase.ImportMetaData{}
+
+	for name, importPath := range s.Imports {
+		imp := &usecase.ImportMetaData{Path: importPath}
+		// NOTE: the real package name is unknown
+		if name == path.Base(importPath) {
+			imp.Name = name
+		} else {
+			imp.Alias = name
+		}
+		imports = append(imports, imp)
+	}
+
+	sort.Slice(imports, func(i, j int) bool { return imports[i].Path < imports[j].Path })
+
+	if len(imports) == 0 {
+		return nil
+	}
+	return imports
+}
+
+// extractSpecInfo builds input data from the spec instead of Go source code.
+func (e extracter) extractSpecInfo(
+	fileName string,
+	src io.Reader,
+) (*usecase.CurryFunctionInputData, error) {
+	s, err := decodeSpec(fileName, src)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode spec: %w", err)
+	}
+
+	conf, err := e.config(fileName)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load config: %w", err)
+	}
+
+	packagePath := s.Path
+	if e.packagePath != "" {
+		packagePath = e.packagePath
+	}
+	if packagePath == "" {
+		packagePath = s.Package
+	}
+	settings := conf.settingsFor(packagePath)
+
+	functions := []*usecase.FunctionInputData{}
+	for _, fnSpec := range s.Functions {
+		if !e.isTarget(fnSpec.Name) || settings.function(fnSpec.Name).Skip {
+			continue
+		}
+
+		if err := settings.checkCallable(fnSpec.Name); err != nil {
+			return nil, err
+		}
+
+		fn, err := e.functionDataFromSpec(s, fnSpec, settings)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to convert %s: %w", fnSpec.Name, err)
+		}
+		functions = append(functions, fn)
+	}
+
+	if len(functions) == 0 {
+		return nil, xerrors.Errorf("no functions found in spec")
+	}
+
+	meta := usecase.CurriedFunctionMetaData{
+		PackageName: s.Package,
+		PackagePath: packagePath,
+		Imports:     s.importsMetaData(),
+	}
+	if settings.output != nil {
+		meta.OutputPackageName = settings.output.Package.value
+		meta.OutputPackagePath = settings.output.Path.value
+	}
+
+	return &usecase.CurryFunctionInputData{
+		Functions:               functions,
+		CurriedFunctionMetaData: meta,
+	}, nil
+}
+
+func (e extracter) functionDataFromSpec(
+	s *spec,
+	fnSpec *functionSpec,
+	settings *settings,
+) (*usecase.FunctionInputData, error) {
+	params := make([]*usecase.ParameterInputData, len(fnSpec.Params))
+	variadic := false
+	for i, p := range fnSpec.Params {
+		typ := p.Type
+
+		// NOTE: variadic parameters are represented as slices in the same way as go/types
+		if strings.HasPrefix(typ, "...") {
+			if i != len(fnSpec.Params)-1 {
+				return nil, xerrors.Errorf("only the last parameter can be variadic (got %s)", p.Name)
+			}
+			typ = "[]" + strings.TrimPrefix(typ, "...")
+			variadic = true
+		}
+
+		t, err := s.qualifiedType(typ)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = &usecase.ParameterInputData{Name: p.Name, Type: t, Incomparable: !isComparableSpecType(typ)}
+	}
+
+	returnTypes := make([]string, len(fnSpec.Results))
+	for i, r := range fnSpec.Results {
+		t, err := s.qualifiedType(r)
+		if err != nil {
+			return nil, err
+		}
+		returnTypes[i] = t
+	}
+
+	curriedFuncName, err := e.curriedFuncNameOf(fnSpec.Name, s.Package, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := e.argumentOrderOf(fnSpec.Name, params, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.FunctionInputData{
+		FuncName:        fnSpec.Name,
+		CurriedFuncName: curriedFuncName,
+		Parameters:      params,
+		ReturnTypes:     returnTypes,
+		ArgumentOrder:   order,
+		Doc:             fnSpec.Doc,
+		Variadic:        variadic,
+	}, nil
+}
@@ -0,0 +1,8 @@
+package test
+
+import (
+	htemplate "html/template"
+	. "strings"
+)
+
+func render(t *htemplate.Template, b *Builder) {}
@@ -0,0 +1,15 @@
+package test
+
+import "context"
+
+func Query(ctx context.Context, table string, limit int) ([]string, error) {
+	return nil, nil
+}
+
+func Skipped(i1, i2 int) int {
+	return i1 + i2
+}
+
+func Fetch(ctx context.Context, url string) error {
+	return nil
+}
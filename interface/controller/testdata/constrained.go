@@ -0,0 +1,8 @@
+//go:build integration
+// +build integration
+
+package test
+
+func sub(i1, i2 int) int {
+	return i1 - i2
+}
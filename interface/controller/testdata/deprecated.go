@@ -0,0 +1,8 @@
+package test
+
+// Join joins two strings.
+//
+// Deprecated: use strings.Join instead.
+func Join(s1, s2 string) string {
+	return s1 + s2
+}
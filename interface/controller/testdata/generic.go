@@ -0,0 +1,9 @@
+package test
+
+func pick[T any](a, b T) T {
+	return a
+}
+
+func pickInt(a, b int) int {
+	return pick(a, b)
+}
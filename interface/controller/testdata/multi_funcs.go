@@ -0,0 +1,17 @@
+package test
+
+type Point struct {
+	X, Y int
+}
+
+func move(p Point, dx int, dy int) Point {
+	return Point{X: p.X + dx, Y: p.Y + dy}
+}
+
+func (p Point) String() string {
+	return "point"
+}
+
+func add(i1 int, i2 int) int {
+	return i1 + i2
+}
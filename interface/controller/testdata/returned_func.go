@@ -0,0 +1,9 @@
+package test
+
+func compile(a int) func(b, c string) error {
+	return nil
+}
+
+func nest(a int) func(string) func(a string, xs ...int) int {
+	return nil
+}
@@ -0,0 +1,9 @@
+package test
+
+func check(int, string) error {
+	return nil
+}
+
+func ignore(_ int, p0 string, _ bool) int {
+	return 0
+}
@@ -0,0 +1,7 @@
+package test
+
+import "strings"
+
+func joinAll(sep string, elems ...string) string {
+	return strings.Join(elems, sep)
+}
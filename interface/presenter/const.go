@@ -0,0 +1,5 @@
+package presenter
+
+// GeneratedCodeHeader is the header comment written on top of every generated file.
+// NOTE: this comment is neccessary to tell analyzer to be ignored
+const GeneratedCodeHeader = "Code generated by chapati; DO NOT EDIT."
@@ -1,7 +1,9 @@
 package presenter
 
 import (
+	"fmt"
 	"io"
+	"strings"
 
 	"golang.org/x/xerrors"
 
@@ -26,16 +28,19 @@ func NewCurryFunctionPresenter(
 
 // Show writes source code of curried function to p.writer.
 func (p *curryFunctionPresenter) Show(out *usecase.CurryFunctionOutputData) error {
-	f := jen.NewFilePath(out.CurriedFunctionMetaData.PackageName)
+	f := jen.NewFilePathName(
+		outputPackagePathOf(out.CurriedFunctionMetaData),
+		outputPackageNameOf(out.CurriedFunctionMetaData),
+	)
 
-	// NOTE: this comment is neccessary to tell analyzer to be ignored
-	f.HeaderComment("Code generated by chapati; DO NOT EDIT.")
+	addHeaderComments(f, out.CurriedFunctionMetaData)
+	importNames := registerImports(f, out, nil)
 
-	curryCode, err := p.curryCode(out.CurriedSignatureList, out.OriginalSignatureList)
+	code, err := p.code(out, importNames)
 	if err != nil {
 		return xerrors.Errorf("failed to generate code: %w", err)
 	}
-	f.Add(curryCode)
+	f.Add(code)
 
 	if err := f.Render(p.writer); err != nil {
 		return xerrors.Errorf("failed to write code: %w", err)
@@ -44,31 +49,175 @@ func (p *curryFunctionPresenter) Show(out *usecase.CurryFunctionOutputData) erro
 	return nil
 }
 
+// addHeaderComments adds the generated code header and build constraints of the source.
+func addHeaderComments(f *jen.File, meta usecase.CurriedFunctionMetaData) {
+	f.HeaderComment(GeneratedCodeHeader)
+
+	// NOTE: comments starting with "//" are rendered as they are
+	for _, c := range meta.BuildConstraints {
+		f.HeaderComment(c)
+	}
+}
+
+// code generates curried functions separated by blank lines.
+// importNames is names of imported packages (guessed if nil).
+func (p *curryFunctionPresenter) code(
+	out *usecase.CurryFunctionOutputData,
+	importNames map[string]string,
+) (jen.Code, error) {
+	if len(out.Functions) == 0 {
+		return nil, xerrors.Errorf("Functions must not be empty")
+	}
+
+	code := jen.Null()
+	for i, fn := range out.Functions {
+		orig := p.originalFuncOf(fn, out.CurriedFunctionMetaData)
+
+		needed := neededIdentifiers(fn, out.CurriedFunctionMetaData, importNames)
+		currySig, origSig := renameShadowingParams(fn.CurriedSignatureList, fn.OriginalSignatureList, needed)
+
+		curryCode, err := p.curryCodeWithCallee(currySig, origSig, orig)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to curry %s: %w", fn.OriginalSignatureList.Name(), err)
+		}
+
+		if i > 0 {
+			code.Line().Line()
+		}
+		code.Add(docCode(fn.CurriedSignatureList.CurriedSignature.Name(), fn.OriginalSignatureList.Name(), fn.Doc))
+		code.Add(curryCode)
+	}
+
+	return code, nil
+}
+
+// docCode generates a doc comment of the curried function followed by the original doc
+// (including "Deprecated:" paragraphs so that linters also flag the curried function).
+func docCode(curriedName, origName, doc string) jen.Code {
+	code := jen.Comment(fmt.Sprintf("%s is the curried form of %s.", curriedName, origName)).Line()
+
+	doc = strings.TrimRight(doc, "\n")
+	if doc == "" {
+		return code
+	}
+
+	code.Comment("").Line()
+	for _, line := range strings.Split(doc, "\n") {
+		code.Comment(line).Line()
+	}
+
+	return code
+}
+
+// originalFunc is the original function called in curried functions.
+type originalFunc struct {
+	callee jen.Code
+	// whether the last parameter is variadic
+	variadic bool
+	// numbers of arguments of each call (nil if all arguments are passed to one call)
+	arities []int
+}
+
+// originalFuncOf qualifies the original function if the code is generated in another package.
+func (p *curryFunctionPresenter) originalFuncOf(
+	fn *usecase.CurriedFunctionOutputData,
+	meta usecase.CurriedFunctionMetaData,
+) originalFunc {
+	orig := originalFunc{
+		callee:   jen.Id(fn.OriginalSignatureList.Name()),
+		variadic: fn.Variadic,
+		arities:  fn.CallArities,
+	}
+	if outputPackagePathOf(meta) != packagePathOf(meta) {
+		orig.callee = jen.Qual(packagePathOf(meta), fn.OriginalSignatureList.Name())
+	}
+	return orig
+}
+
+// call calls the original function with args
+// (the last argument is expanded if the function is variadic, and args are split by arities in deep currying).
+//
+//	F(a, b)
+//	F(a, bs...)
+//	Make(a)(b, c)
+func (o originalFunc) call(args []jen.Code) *jen.Statement {
+	args = append([]jen.Code{}, args...)
+	if o.variadic && len(args) > 0 {
+		args[len(args)-1] = jen.Add(args[len(args)-1]).Op("...")
+	}
+
+	arities := o.arities
+	if len(arities) == 0 {
+		arities = []int{len(args)}
+	}
+
+	code := jen.Add(o.callee)
+	for _, n := range arities {
+		code.Call(args[:n]...)
+		args = args[n:]
+	}
+	return code
+}
+
+func packagePathOf(meta usecase.CurriedFunctionMetaData) string {
+	if meta.PackagePath == "" {
+		return meta.PackageName
+	}
+	return meta.PackagePath
+}
+
+func outputPackagePathOf(meta usecase.CurriedFunctionMetaData) string {
+	if meta.OutputPackagePath == "" {
+		return packagePathOf(meta)
+	}
+	return meta.OutputPackagePath
+}
+
+func outputPackageNameOf(meta usecase.CurriedFunctionMetaData) string {
+	if meta.OutputPackageName == "" {
+		return meta.PackageName
+	}
+	return meta.OutputPackageName
+}
+
 func (p *curryFunctionPresenter) curryCode(
 	currySig *domain.CurriedSignatureList,
 	origSig *domain.FunctionSignature,
+) (jen.Code, error) {
+	return p.curryCodeWithCallee(currySig, origSig, originalFunc{callee: jen.Id(origSig.Name())})
+}
+
+func (p *curryFunctionPresenter) curryCodeWithCallee(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
 ) (jen.Code, error) {
 	if len(currySig.PartiallyAppliedSignatures) == 0 {
 		return nil, xerrors.Errorf("PartiallyAppliedSignatures must not be zero")
 	}
 
-	reversedSigs := make([]*domain.FunctionSignature, len(currySig.PartiallyAppliedSignatures))
-	for i, sig := range currySig.PartiallyAppliedSignatures {
-		reversedSigs[len(currySig.PartiallyAppliedSignatures)-i-1] = sig
-	}
-
 	// inner most function
-	code := p.curryCoreCode(reversedSigs[0], origSig)
+	partials := currySig.PartiallyAppliedSignatures
+	core := p.curryCoreCode(partials[len(partials)-1], origSig, orig)
+
+	return p.stagesCode(currySig, core), nil
+}
+
+// stagesCode wraps the inner most function by the other partially applied functions and the curried function.
+func (p *curryFunctionPresenter) stagesCode(
+	currySig *domain.CurriedSignatureList,
+	core jen.Code,
+) jen.Code {
+	partials := currySig.PartiallyAppliedSignatures
+	code := core
 
 	// inner functions from inner to outer
-	for _, sig := range reversedSigs[1:] {
-		code = p.curryMiddleCode(sig, code)
+	for i := len(partials) - 2; i >= 0; i-- {
+		code = p.curryMiddleCode(partials[i], code)
 	}
 
 	// outer function
-	code = p.curryOuterCode(currySig.CurriedSignature, code)
-
-	return code, nil
+	return p.curryOuterCode(currySig.CurriedSignature, code)
 }
 
 func (p *curryFunctionPresenter) curryOuterCode(
@@ -119,6 +268,7 @@ func (p *curryFunctionPresenter) curryMiddleCode(
 func (p *curryFunctionPresenter) curryCoreCode(
 	sig *domain.FunctionSignature,
 	origSig *domain.FunctionSignature,
+	orig originalFunc,
 ) jen.Code {
 	fn := jen.Func()
 
@@ -130,11 +280,16 @@ func (p *curryFunctionPresenter) curryCoreCode(
 		fn.Params(renderTypes(sig.ReturnTypes())...)
 	}
 
+	call := orig.call(renderParamValues(origSig.Parameters()))
+
+	// NOTE: function without return values cannot be returned
+	if len(origSig.ReturnTypes()) == 0 {
+		fn.Block(call)
+		return fn
+	}
+
 	fn.Block(
-		jen.Return(
-			jen.Id(origSig.Name()).
-				Call(renderParamValues(origSig.Parameters())...),
-		),
+		jen.Return(call),
 	)
 
 	return fn
@@ -0,0 +1,81 @@
+package presenter
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+)
+
+// futurePackagePath is the package of Future returned by Async variants.
+const futurePackagePath = "github.com/syuparn/chapati/future"
+
+// isAsyncable returns true if sig returns at most one value and an optional error.
+func isAsyncable(sig *domain.FunctionSignature) bool {
+	returnTypes := sig.ReturnTypes()
+	if returnsError(sig) {
+		returnTypes = returnTypes[:len(returnTypes)-1]
+	}
+
+	switch len(returnTypes) {
+	case 0:
+		return true
+	case 1:
+		return !returnTypes[0].IsFuncType()
+	default:
+		return false
+	}
+}
+
+// asyncCode generates the variant calling the original function in a new goroutine.
+// Futures of functions without values have struct{} values.
+//
+//	func AsyncCurriedF(a A) func(B) *future.Future[R] {
+//		return func(b B) *future.Future[R] {
+//			return future.Go(func() (R, error) {
+//				return F(a, b)
+//			})
+//		}
+//	}
+func (p *curryFunctionVariantPresenter) asyncCode(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	curriedName := currySig.CurriedSignature.Name()
+	name := variantName("Async", curriedName)
+
+	call := orig.call(renderParamValues(origSig.Parameters()))
+	empty := jen.Struct().Values()
+
+	returnTypes := origSig.ReturnTypes()
+	valueType := domain.TermType("struct{}")
+	var body []jen.Code
+	switch {
+	case len(returnTypes) == 0:
+		body = []jen.Code{call, jen.Return(empty, jen.Nil())}
+	case len(returnTypes) == 1 && returnsError(origSig):
+		body = []jen.Code{jen.Return(empty, call)}
+	case len(returnTypes) == 1:
+		valueType = returnTypes[0].(domain.TermType)
+		body = []jen.Code{jen.Return(call, jen.Nil())}
+	default:
+		valueType = returnTypes[0].(domain.TermType)
+		body = []jen.Code{jen.Return(call)}
+	}
+
+	futureType := domain.TermType(fmt.Sprintf("*%s.Future[%s]", futurePackagePath, valueType))
+	sigs := withReturnTypes(currySig, name, []domain.Type{futureType})
+
+	goCode := jen.Return(jen.Qual(futurePackagePath, "Go").Call(
+		jen.Func().Params().Params(renderType(valueType), jen.Error()).Block(body...),
+	))
+
+	partials := sigs.PartiallyAppliedSignatures
+	core := p.variantCoreCode(partials[len(partials)-1], []jen.Code{goCode})
+
+	return jen.Comment(fmt.Sprintf("%s is like %s but calls %s in a new goroutine and returns its future.", name, curriedName, origSig.Name())).
+		Line().
+		Add(p.stagesCode(sigs, core))
+}
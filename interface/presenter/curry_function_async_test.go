@@ -0,0 +1,183 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionVariantPresenterShowAsync(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       *usecase.CurriedFunctionOutputData
+		expected string
+	}{
+		{
+			"value and error",
+			divOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "github.com/syuparn/chapati/future"
+
+			// CurriedDiv is the curried form of Div.
+			func CurriedDiv(a int) func(int) (int, error) {
+				return func(b int) (int, error) {
+					return Div(a, b)
+				}
+			}
+
+			// AsyncCurriedDiv is like CurriedDiv but calls Div in a new goroutine and returns its future.
+			func AsyncCurriedDiv(a int) func(int) *future.Future[int] {
+				return func(b int) *future.Future[int] {
+					return future.Go(func() (int, error) {
+						return Div(a, b)
+					})
+				}
+			}
+			`,
+		},
+		{
+			"value",
+			ternaryAddOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "github.com/syuparn/chapati/future"
+
+			// CurriedAdd is the curried form of Add.
+			//
+			// Add adds ints.
+			func CurriedAdd(i1 int) func(int) func(int) int {
+				return func(i2 int) func(int) int {
+					return func(i3 int) int {
+						return Add(i1, i2, i3)
+					}
+				}
+			}
+
+			// AsyncCurriedAdd is like CurriedAdd but calls Add in a new goroutine and returns its future.
+			func AsyncCurriedAdd(i1 int) func(int) func(int) *future.Future[int] {
+				return func(i2 int) func(int) *future.Future[int] {
+					return func(i3 int) *future.Future[int] {
+						return future.Go(func() (int, error) {
+							return Add(i1, i2, i3), nil
+						})
+					}
+				}
+			}
+			`,
+		},
+		{
+			"error",
+			returningOutputData("validate", "curriedValidate",
+				domain.NewParameter("min", domain.TermType("int")),
+				domain.NewParameter("x", domain.TermType("int")),
+				domain.TermType("error"),
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "github.com/syuparn/chapati/future"
+
+			// curriedValidate is the curried form of validate.
+			func curriedValidate(min int) func(int) error {
+				return func(x int) error {
+					return validate(min, x)
+				}
+			}
+
+			// asyncCurriedValidate is like curriedValidate but calls validate in a new goroutine and returns its future.
+			func asyncCurriedValidate(min int) func(int) *future.Future[struct{}] {
+				return func(x int) *future.Future[struct{}] {
+					return future.Go(func() (struct{}, error) {
+						return struct{}{}, validate(min, x)
+					})
+				}
+			}
+			`,
+		},
+		{
+			"nothing is returned",
+			variadicOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "github.com/syuparn/chapati/future"
+
+			// CurriedPrint is the curried form of print.
+			func CurriedPrint(w int) func([]string) {
+				return func(args []string) {
+					print(w, args...)
+				}
+			}
+
+			// AsyncCurriedPrint is like CurriedPrint but calls print in a new goroutine and returns its future.
+			func AsyncCurriedPrint(w int) func([]string) *future.Future[struct{}] {
+				return func(args []string) *future.Future[struct{}] {
+					return future.Go(func() (struct{}, error) {
+						print(w, args...)
+						return struct{}{}, nil
+					})
+				}
+			}
+			`,
+		},
+		{
+			"multiple values are not supported",
+			returningOutputData("Cut", "CurriedCut",
+				domain.NewParameter("sep", domain.TermType("string")),
+				domain.NewParameter("s", domain.TermType("string")),
+				domain.TermType("string"), domain.TermType("string"),
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedCut is the curried form of Cut.
+			func CurriedCut(sep string) func(string) (string, string) {
+				return func(s string) (string, string) {
+					return Cut(sep, s)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionVariantPresenter(&buf, AsyncVariant)
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{tt.fn},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package presenter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// benchImports are packages imported by generated benchmarks.
+var benchImports = []*usecase.ImportMetaData{
+	{Path: "runtime", Name: "runtime"},
+	{Path: "testing", Name: "testing"},
+}
+
+type curryFunctionBenchPresenter struct {
+	curryFunctionTestPresenter
+}
+
+// NewCurryFunctionBenchPresenter creates a new CurryFunctionOutputPort,
+// which writes benchmarks of each curried function and the original one.
+func NewCurryFunctionBenchPresenter(
+	writer io.Writer,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionBenchPresenter{
+		curryFunctionTestPresenter{
+			curryFunctionPresenter: curryFunctionPresenter{writer: writer},
+		},
+	}
+}
+
+// NewCurryFunctionStructBenchPresenter creates a new CurryFunctionOutputPort,
+// which writes benchmarks of code generated by NewCurryFunctionStructPresenter.
+func NewCurryFunctionStructBenchPresenter(
+	writer io.Writer,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionBenchPresenter{
+		curryFunctionTestPresenter{
+			curryFunctionPresenter: curryFunctionPresenter{writer: writer},
+			structStyle:            true,
+		},
+	}
+}
+
+// Show writes source code of benchmarks of curried functions to p.writer.
+func (p *curryFunctionBenchPresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	if len(out.Functions) == 0 {
+		return xerrors.Errorf("Functions must not be empty")
+	}
+
+	f := jen.NewFilePathName(
+		outputPackagePathOf(out.CurriedFunctionMetaData),
+		outputPackageNameOf(out.CurriedFunctionMetaData),
+	)
+
+	addHeaderComments(f, out.CurriedFunctionMetaData)
+	importNames := registerImports(f, out, benchImports)
+
+	for _, fn := range out.Functions {
+		orig := p.originalFuncOf(fn, out.CurriedFunctionMetaData)
+
+		// NOTE: arguments and results are declared as local variables in benchmarks
+		extra := []string{fn.CurriedSignatureList.CurriedSignature.Name(), importNames["runtime"], "b", "i"}
+		extra = append(extra, resultNames(fn.OriginalSignatureList.ReturnTypes())...)
+		needed := neededIdentifiers(fn, out.CurriedFunctionMetaData, importNames, extra...)
+		currySig, origSig := renameShadowingParams(fn.CurriedSignatureList, fn.OriginalSignatureList, needed)
+
+		f.Add(p.benchCode(currySig, origSig, orig))
+	}
+
+	if err := f.Render(p.writer); err != nil {
+		return xerrors.Errorf("failed to write code: %w", err)
+	}
+
+	return nil
+}
+
+// benchCode generates a benchmark calling the fully applied curried function
+// and one calling the original function directly.
+func (p *curryFunctionBenchPresenter) benchCode(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	name := strings.Title(origSig.Name())
+
+	curried := p.curriedCallCode(currySig, renderParamValue)
+	original := p.originalCallCode(origSig, orig, renderParamValue)
+
+	return p.benchFuncCode("BenchmarkCurried"+name, origSig, curried).
+		Line().
+		Add(p.benchFuncCode("BenchmarkDirect"+name, origSig, original))
+}
+
+// benchFuncCode generates a benchmark of the call.
+//
+//	func BenchmarkCurriedF(b *testing.B) {
+//		// TODO: set arguments of F (zero values are used)
+//		var (
+//			x X
+//			y Y
+//		)
+//		var r0 R
+//
+//		b.ReportAllocs()
+//		for i := 0; i < b.N; i++ {
+//			r0 = CurriedF(x)(y)
+//		}
+//		runtime.KeepAlive(r0)
+//	}
+func (p *curryFunctionBenchPresenter) benchFuncCode(
+	name string,
+	origSig *domain.FunctionSignature,
+	call jen.Code,
+) *jen.Statement {
+	args := []jen.Code{}
+	for _, param := range origSig.Parameters() {
+		args = append(args, renderParam(param))
+	}
+
+	body := []jen.Code{
+		jen.Comment(fmt.Sprintf("TODO: set arguments of %s (zero values are used)", origSig.Name())),
+		jen.Var().Defs(args...),
+	}
+
+	returnTypes := origSig.ReturnTypes()
+	names := resultNames(returnTypes)
+
+	loopBody := jen.Add(call)
+	if len(returnTypes) > 0 {
+		results := make([]jen.Code, len(returnTypes))
+		ids := make([]jen.Code, len(returnTypes))
+		for i, t := range returnTypes {
+			results[i] = jen.Id(names[i]).Add(renderType(t))
+			ids[i] = jen.Id(names[i])
+		}
+
+		if len(results) == 1 {
+			body = append(body, jen.Var().Add(results[0]))
+		} else {
+			body = append(body, jen.Var().Defs(results...))
+		}
+		loopBody = jen.List(ids...).Op("=").Add(call)
+	}
+
+	body = append(body,
+		jen.Line(),
+		jen.Id("b").Dot("ReportAllocs").Call(),
+		jen.For(
+			jen.Id("i").Op(":=").Lit(0),
+			jen.Id("i").Op("<").Id("b").Dot("N"),
+			jen.Id("i").Op("++"),
+		).Block(loopBody),
+	)
+
+	// NOTE: results are kept alive so that calls are not optimized away
+	for _, r := range names {
+		body = append(body, jen.Qual("runtime", "KeepAlive").Call(jen.Id(r)))
+	}
+
+	return jen.Func().Id(name).
+		Params(jen.Id("b").Op("*").Qual("testing", "B")).
+		Block(body...).
+		Line()
+}
+
+// resultNames returns names of local variables holding results.
+func resultNames(returnTypes []domain.Type) []string {
+	names := make([]string, len(returnTypes))
+	for i := range returnTypes {
+		names[i] = fmt.Sprintf("r%d", i)
+	}
+	return names
+}
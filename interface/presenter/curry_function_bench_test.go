@@ -0,0 +1,181 @@
+package presenter
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionBenchPresenterShow(t *testing.T) {
+	tests := []struct {
+		name        string
+		constructor func(io.Writer) usecase.CurryFunctionOutputPort
+		fn          *usecase.CurriedFunctionOutputData
+		expected    string
+	}{
+		{
+			"closure style",
+			NewCurryFunctionBenchPresenter,
+			ternaryAddOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import (
+				"runtime"
+				"testing"
+			)
+
+			func BenchmarkCurriedAdd(b *testing.B) {
+				// TODO: set arguments of Add (zero values are used)
+				var (
+					i1 int
+					i2 int
+					i3 int
+				)
+				var r0 int
+
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					r0 = CurriedAdd(i1)(i2)(i3)
+				}
+				runtime.KeepAlive(r0)
+			}
+
+			func BenchmarkDirectAdd(b *testing.B) {
+				// TODO: set arguments of Add (zero values are used)
+				var (
+					i1 int
+					i2 int
+					i3 int
+				)
+				var r0 int
+
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					r0 = Add(i1, i2, i3)
+				}
+				runtime.KeepAlive(r0)
+			}
+			`,
+		},
+		{
+			"struct style",
+			NewCurryFunctionStructBenchPresenter,
+			ternaryAddOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import (
+				"runtime"
+				"testing"
+			)
+
+			func BenchmarkCurriedAdd(b *testing.B) {
+				// TODO: set arguments of Add (zero values are used)
+				var (
+					i1 int
+					i2 int
+					i3 int
+				)
+				var r0 int
+
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					r0 = CurriedAdd(i1).Apply(i2).Apply(i3)
+				}
+				runtime.KeepAlive(r0)
+			}
+
+			func BenchmarkDirectAdd(b *testing.B) {
+				// TODO: set arguments of Add (zero values are used)
+				var (
+					i1 int
+					i2 int
+					i3 int
+				)
+				var r0 int
+
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					r0 = Add(i1, i2, i3)
+				}
+				runtime.KeepAlive(r0)
+			}
+			`,
+		},
+		{
+			"parameters conflicting with local variables",
+			NewCurryFunctionBenchPresenter,
+			binaryFunctionOutputData("write", "CurriedWrite",
+				domain.NewParameter("b", domain.TermType("[]byte")),
+				domain.NewParameter("i", domain.TermType("int")),
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "testing"
+
+			func BenchmarkCurriedWrite(b *testing.B) {
+				// TODO: set arguments of write (zero values are used)
+				var (
+					b1 []byte
+					i1 int
+				)
+
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					CurriedWrite(b1)(i1)
+				}
+			}
+
+			func BenchmarkDirectWrite(b *testing.B) {
+				// TODO: set arguments of write (zero values are used)
+				var (
+					b1 []byte
+					i1 int
+				)
+
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					write(b1, i1)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := tt.constructor(&buf)
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{tt.fn},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
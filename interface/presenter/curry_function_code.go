@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"golang.org/x/xerrors"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/usecase"
+)
+
+type curryFunctionCodePresenter struct {
+	curryFunctionPresenter
+	receive func(jen.Code)
+}
+
+// NewCurryFunctionCodePresenter creates a new CurryFunctionOutputPort,
+// which passes generated code to receive instead of writing a source file.
+func NewCurryFunctionCodePresenter(
+	receive func(jen.Code),
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionCodePresenter{
+		receive: receive,
+	}
+}
+
+// Show passes code of curried functions to p.receive.
+func (p *curryFunctionCodePresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	code, err := p.code(out, nil)
+	if err != nil {
+		return xerrors.Errorf("failed to generate code: %w", err)
+	}
+
+	p.receive(code)
+	return nil
+}
@@ -0,0 +1,93 @@
+package presenter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionCodePresenterShow(t *testing.T) {
+	tests := []struct {
+		name     string
+		out      *usecase.CurryFunctionOutputData
+		expected string
+	}{
+		{
+			"arity 2 currying",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"myFunc",
+							[]domain.Parameter{
+								domain.NewParameter("arg0", domain.TermType("string")),
+								domain.NewParameter("arg1", domain.TermType("int")),
+							},
+							[]domain.Type{
+								domain.TermType("error"),
+							},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature(
+								"curriedMyFunc",
+								[]domain.Parameter{
+									domain.NewParameter("arg0", domain.TermType("string")),
+								},
+								[]domain.Type{
+									domain.NewFuncType(
+										[]domain.Type{domain.TermType("int")},
+										[]domain.Type{domain.TermType("error")},
+									),
+								},
+							),
+							[]*domain.FunctionSignature{
+								domain.NewFunctionSignature(
+									"myFunc1",
+									[]domain.Parameter{
+										domain.NewParameter("arg1", domain.TermType("int")),
+									},
+									[]domain.Type{
+										domain.TermType("error"),
+									},
+								),
+							},
+						),
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			},
+			`
+			// curriedMyFunc is the curried form of myFunc.
+			func curriedMyFunc(arg0 string) func(int) error {
+				return func(arg1 int) error {
+					return myFunc(arg0, arg1)
+				}
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var code jen.Code
+			p := NewCurryFunctionCodePresenter(func(c jen.Code) { code = c })
+
+			if err := p.Show(tt.out); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := fmt.Sprintf("%#v", code)
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package presenter
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+)
+
+// resultPackagePath is the package of Result returned by Result variants.
+const resultPackagePath = "github.com/syuparn/chapati/result"
+
+func returnsError(sig *domain.FunctionSignature) bool {
+	returnTypes := sig.ReturnTypes()
+	return len(returnTypes) > 0 && returnTypes[len(returnTypes)-1] == domain.TermType("error")
+}
+
+// returnsValueAndError returns true if sig returns (T, error).
+func returnsValueAndError(sig *domain.FunctionSignature) bool {
+	returnTypes := sig.ReturnTypes()
+	return len(returnTypes) == 2 && !returnTypes[0].IsFuncType() && returnsError(sig)
+}
+
+// mustCode generates the variant panicking on error.
+//
+//	func MustCurriedF(a A) func(B) R {
+//		return func(b B) R {
+//			r0, err := F(a, b)
+//			if err != nil {
+//				panic(err)
+//			}
+//			return r0
+//		}
+//	}
+func (p *curryFunctionVariantPresenter) mustCode(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	curriedName := currySig.CurriedSignature.Name()
+	name := variantName("Must", curriedName)
+
+	returnTypes := origSig.ReturnTypes()
+	values := returnTypes[:len(returnTypes)-1]
+	sigs := withReturnTypes(currySig, name, values)
+
+	call := orig.call(renderParamValues(origSig.Parameters()))
+	panicCode := jen.Panic(jen.Err())
+
+	var body []jen.Code
+	if len(values) == 0 {
+		body = []jen.Code{
+			jen.If(jen.Err().Op(":=").Add(call), jen.Err().Op("!=").Nil()).Block(panicCode),
+		}
+	} else {
+		ids := []jen.Code{}
+		for _, r := range resultNames(values) {
+			ids = append(ids, jen.Id(r))
+		}
+		body = []jen.Code{
+			jen.List(append(ids, jen.Err())...).Op(":=").Add(call),
+			jen.If(jen.Err().Op("!=").Nil()).Block(panicCode),
+			jen.Return(ids...),
+		}
+	}
+
+	partials := sigs.PartiallyAppliedSignatures
+	core := p.variantCoreCode(partials[len(partials)-1], body)
+
+	return jen.Comment(fmt.Sprintf("%s is like %s but panics if %s returns an error.", name, curriedName, origSig.Name())).
+		Line().
+		Add(p.stagesCode(sigs, core))
+}
+
+// resultCode generates the variant returning result.Result.
+//
+//	func ResultCurriedF(a A) func(B) result.Result[R] {
+//		return func(b B) result.Result[R] {
+//			return result.Of(F(a, b))
+//		}
+//	}
+func (p *curryFunctionVariantPresenter) resultCode(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	curriedName := currySig.CurriedSignature.Name()
+	name := variantName("Result", curriedName)
+
+	valueType := origSig.ReturnTypes()[0].(domain.TermType)
+	resultType := domain.TermType(fmt.Sprintf("%s.Result[%s]", resultPackagePath, valueType))
+	sigs := withReturnTypes(currySig, name, []domain.Type{resultType})
+
+	call := orig.call(renderParamValues(origSig.Parameters()))
+	body := []jen.Code{
+		jen.Return(jen.Qual(resultPackagePath, "Of").Call(call)),
+	}
+
+	partials := sigs.PartiallyAppliedSignatures
+	core := p.variantCoreCode(partials[len(partials)-1], body)
+
+	return jen.Comment(fmt.Sprintf("%s is like %s but returns the result and the error of %s as result.Result.", name, curriedName, origSig.Name())).
+		Line().
+		Add(p.stagesCode(sigs, core))
+}
@@ -0,0 +1,240 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func returningOutputData(
+	name string,
+	curriedName string,
+	p0 domain.Parameter,
+	p1 domain.Parameter,
+	returnTypes ...domain.Type,
+) *usecase.CurriedFunctionOutputData {
+	return &usecase.CurriedFunctionOutputData{
+		OriginalSignatureList: domain.NewFunctionSignature(name, []domain.Parameter{p0, p1}, returnTypes),
+		CurriedSignatureList: domain.NewCurriedSignatureList(
+			domain.NewFunctionSignature(
+				curriedName,
+				[]domain.Parameter{p0},
+				[]domain.Type{domain.NewFuncType([]domain.Type{p1.Type}, returnTypes)},
+			),
+			[]*domain.FunctionSignature{
+				domain.NewFunctionSignature(name+"1", []domain.Parameter{p1}, returnTypes),
+			},
+		),
+	}
+}
+
+var divOutputData = returningOutputData("Div", "CurriedDiv",
+	domain.NewParameter("a", domain.TermType("int")),
+	domain.NewParameter("b", domain.TermType("int")),
+	domain.TermType("int"), domain.TermType("error"),
+)
+
+func TestCurryFunctionVariantPresenterShowErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		variants []Variant
+		fn       *usecase.CurriedFunctionOutputData
+		expected string
+	}{
+		{
+			"must and result",
+			[]Variant{MustVariant, ResultVariant},
+			divOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "github.com/syuparn/chapati/result"
+
+			// CurriedDiv is the curried form of Div.
+			func CurriedDiv(a int) func(int) (int, error) {
+				return func(b int) (int, error) {
+					return Div(a, b)
+				}
+			}
+
+			// MustCurriedDiv is like CurriedDiv but panics if Div returns an error.
+			func MustCurriedDiv(a int) func(int) int {
+				return func(b int) int {
+					r0, err := Div(a, b)
+					if err != nil {
+						panic(err)
+					}
+					return r0
+				}
+			}
+
+			// ResultCurriedDiv is like CurriedDiv but returns the result and the error of Div as result.Result.
+			func ResultCurriedDiv(a int) func(int) result.Result[int] {
+				return func(b int) result.Result[int] {
+					return result.Of(Div(a, b))
+				}
+			}
+			`,
+		},
+		{
+			"result only",
+			[]Variant{ResultVariant},
+			divOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "github.com/syuparn/chapati/result"
+
+			// CurriedDiv is the curried form of Div.
+			func CurriedDiv(a int) func(int) (int, error) {
+				return func(b int) (int, error) {
+					return Div(a, b)
+				}
+			}
+
+			// ResultCurriedDiv is like CurriedDiv but returns the result and the error of Div as result.Result.
+			func ResultCurriedDiv(a int) func(int) result.Result[int] {
+				return func(b int) result.Result[int] {
+					return result.Of(Div(a, b))
+				}
+			}
+			`,
+		},
+		{
+			"only error is returned",
+			[]Variant{MustVariant, ResultVariant},
+			returningOutputData("validate", "curriedValidate",
+				domain.NewParameter("min", domain.TermType("int")),
+				domain.NewParameter("x", domain.TermType("int")),
+				domain.TermType("error"),
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// curriedValidate is the curried form of validate.
+			func curriedValidate(min int) func(int) error {
+				return func(x int) error {
+					return validate(min, x)
+				}
+			}
+
+			// mustCurriedValidate is like curriedValidate but panics if validate returns an error.
+			func mustCurriedValidate(min int) func(int) {
+				return func(x int) {
+					if err := validate(min, x); err != nil {
+						panic(err)
+					}
+				}
+			}
+			`,
+		},
+		{
+			"multiple values and error",
+			[]Variant{MustVariant, ResultVariant},
+			returningOutputData("Cut", "CurriedCut",
+				domain.NewParameter("sep", domain.TermType("string")),
+				domain.NewParameter("err", domain.TermType("string")),
+				domain.TermType("string"), domain.TermType("string"), domain.TermType("error"),
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedCut is the curried form of Cut.
+			func CurriedCut(sep string) func(string) (string, string, error) {
+				return func(err string) (string, string, error) {
+					return Cut(sep, err)
+				}
+			}
+
+			// MustCurriedCut is like CurriedCut but panics if Cut returns an error.
+			func MustCurriedCut(sep string) func(string) (string, string) {
+				return func(err1 string) (string, string) {
+					r0, r1, err := Cut(sep, err1)
+					if err != nil {
+						panic(err)
+					}
+					return r0, r1
+				}
+			}
+			`,
+		},
+		{
+			"error is not returned",
+			[]Variant{MustVariant, ResultVariant},
+			ternaryAddOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedAdd is the curried form of Add.
+			//
+			// Add adds ints.
+			func CurriedAdd(i1 int) func(int) func(int) int {
+				return func(i2 int) func(int) int {
+					return func(i3 int) int {
+						return Add(i1, i2, i3)
+					}
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionVariantPresenter(&buf, tt.variants...)
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{tt.fn},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestVariantName(t *testing.T) {
+	tests := []struct {
+		prefix      string
+		curriedName string
+		expected    string
+	}{
+		{"Must", "CurriedDiv", "MustCurriedDiv"},
+		{"Must", "curriedDiv", "mustCurriedDiv"},
+		{"Result", "curriedDiv", "resultCurriedDiv"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			actual := variantName(tt.prefix, tt.curriedName)
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package presenter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+type curryFunctionExplainPresenter struct {
+	writer io.Writer
+}
+
+// NewCurryFunctionExplainPresenter creates a new CurryFunctionOutputPort,
+// which explains curried types in arrow notation instead of writing source code.
+func NewCurryFunctionExplainPresenter(
+	writer io.Writer,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionExplainPresenter{
+		writer: writer,
+	}
+}
+
+// Show writes the curried type of each function and Go types of its stages to p.writer.
+//
+//	Add :: int -> int -> int
+//	  CurriedAdd      :: func(int) func(int) int
+//	  CurriedAdd(i1)  :: func(int) int
+func (p *curryFunctionExplainPresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	if len(out.Functions) == 0 {
+		return xerrors.Errorf("Functions must not be empty")
+	}
+
+	q := newTypeQualifier(out.CurriedFunctionMetaData)
+
+	for i, fn := range out.Functions {
+		if i > 0 {
+			fmt.Fprintln(p.writer)
+		}
+
+		if err := p.explain(fn, q); err != nil {
+			return xerrors.Errorf("failed to explain %s: %w", fn.OriginalSignatureList.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+func (p *curryFunctionExplainPresenter) explain(fn *usecase.CurriedFunctionOutputData, q *typeQualifier) error {
+	currySig := fn.CurriedSignatureList
+	stages := append([]*domain.FunctionSignature{currySig.CurriedSignature}, currySig.PartiallyAppliedSignatures...)
+
+	// NOTE: parameters are shown in the curried order
+	arrows := []string{}
+	for _, stage := range stages {
+		for _, param := range stage.Parameters() {
+			arrows = append(arrows, q.text(param.Type))
+		}
+	}
+	arrows = append(arrows, q.resultsText(fn.OriginalSignatureList.ReturnTypes(), true))
+
+	fmt.Fprintf(p.writer, "%s :: %s\n", fn.OriginalSignatureList.Name(), strings.Join(arrows, " -> "))
+
+	w := tabwriter.NewWriter(p.writer, 0, 4, 2, ' ', 0)
+
+	label := currySig.CurriedSignature.Name()
+	for _, stage := range stages {
+		fmt.Fprintf(w, "  %s\t:: %s\n", label, q.text(stage.Type()))
+
+		for _, param := range stage.Parameters() {
+			label += "(" + param.Name + ")"
+		}
+	}
+
+	return w.Flush()
+}
+
+// typeQualifier shortens import paths in types into package names.
+type typeQualifier struct {
+	packagePath string
+	imports     map[string]*usecase.ImportMetaData
+}
+
+func newTypeQualifier(meta usecase.CurriedFunctionMetaData) *typeQualifier {
+	imports := map[string]*usecase.ImportMetaData{}
+	for _, imp := range meta.Imports {
+		imports[imp.Path] = imp
+	}
+
+	return &typeQualifier{
+		packagePath: packagePathOf(meta),
+		imports:     imports,
+	}
+}
+
+// text returns the type as written in the source file
+// (e.g. "*database/sql.DB" -> "*sql.DB").
+func (q *typeQualifier) text(t domain.Type) string {
+	if !t.IsFuncType() {
+		return q.termText(string(t.(domain.TermType)))
+	}
+
+	ft := t.(domain.FuncType)
+
+	params := make([]string, len(ft.ParamTypes()))
+	for i, pt := range ft.ParamTypes() {
+		params[i] = q.text(pt)
+	}
+
+	results := q.resultsText(ft.ReturnTypes(), false)
+	if results != "" {
+		results = " " + results
+	}
+
+	return "func(" + strings.Join(params, ", ") + ")" + results
+}
+
+// resultsText returns returned types ("()" is returned for no results if showsEmpty is true).
+func (q *typeQualifier) resultsText(types []domain.Type, showsEmpty bool) string {
+	texts := make([]string, len(types))
+	for i, t := range types {
+		texts[i] = q.text(t)
+	}
+
+	switch len(texts) {
+	case 0:
+		if showsEmpty {
+			return "()"
+		}
+		return ""
+	case 1:
+		return texts[0]
+	default:
+		return "(" + strings.Join(texts, ", ") + ")"
+	}
+}
+
+func (q *typeQualifier) termText(s string) string {
+	return qualifiedNamePattern.ReplaceAllStringFunc(s, func(name string) string {
+		m := qualifiedNamePattern.FindStringSubmatch(name)
+		importPath, typeName := m[1], m[2]
+
+		// NOTE: types in the source package are not qualified
+		if importPath == q.packagePath {
+			return typeName
+		}
+
+		if imp, ok := q.imports[importPath]; ok {
+			return importNameOf(imp) + "." + typeName
+		}
+		return guessPackageName(importPath) + "." + typeName
+	})
+}
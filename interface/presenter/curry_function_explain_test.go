@@ -0,0 +1,143 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionExplainPresenterShow(t *testing.T) {
+	rowsAndError := []domain.Type{domain.TermType("[]example.com/app.Row"), domain.TermType("error")}
+
+	tests := []struct {
+		name     string
+		out      *usecase.CurryFunctionOutputData
+		expected string
+	}{
+		{
+			"arity 2 currying",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"Add",
+							[]domain.Parameter{
+								domain.NewParameter("i1", domain.TermType("int")),
+								domain.NewParameter("i2", domain.TermType("int")),
+							},
+							[]domain.Type{domain.TermType("int")},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature(
+								"CurriedAdd",
+								[]domain.Parameter{domain.NewParameter("i1", domain.TermType("int"))},
+								[]domain.Type{domain.NewFuncType(
+									[]domain.Type{domain.TermType("int")},
+									[]domain.Type{domain.TermType("int")},
+								)},
+							),
+							[]*domain.FunctionSignature{
+								domain.NewFunctionSignature(
+									"Add1",
+									[]domain.Parameter{domain.NewParameter("i2", domain.TermType("int"))},
+									[]domain.Type{domain.TermType("int")},
+								),
+							},
+						),
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			},
+			`
+			Add :: int -> int -> int
+			  CurriedAdd      :: func(int) func(int) int
+			  CurriedAdd(i1)  :: func(int) int
+			`,
+		},
+		{
+			"qualified types and multiple results",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"Query",
+							[]domain.Parameter{
+								domain.NewParameter("db", domain.TermType("*database/sql.DB")),
+								domain.NewParameter("ctx", domain.TermType("context.Context")),
+								domain.NewParameter("q", domain.TermType("string")),
+							},
+							rowsAndError,
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature(
+								"CurriedQuery",
+								[]domain.Parameter{domain.NewParameter("db", domain.TermType("*database/sql.DB"))},
+								[]domain.Type{domain.NewFuncType(
+									[]domain.Type{domain.TermType("context.Context")},
+									[]domain.Type{domain.NewFuncType([]domain.Type{domain.TermType("string")}, rowsAndError)},
+								)},
+							),
+							[]*domain.FunctionSignature{
+								domain.NewFunctionSignature(
+									"Query1",
+									[]domain.Parameter{domain.NewParameter("ctx", domain.TermType("context.Context"))},
+									[]domain.Type{domain.NewFuncType([]domain.Type{domain.TermType("string")}, rowsAndError)},
+								),
+								domain.NewFunctionSignature(
+									"Query2",
+									[]domain.Parameter{domain.NewParameter("q", domain.TermType("string"))},
+									rowsAndError,
+								),
+							},
+						),
+					},
+					binaryFunctionOutputData("write", "CurriedWrite",
+						domain.NewParameter("w", domain.TermType("io.Writer")),
+						domain.NewParameter("v", domain.TermType("*gopkg.in/yaml.v3.Node")),
+					),
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "app",
+					PackagePath: "example.com/app",
+					Imports: []*usecase.ImportMetaData{
+						{Path: "gopkg.in/yaml.v3", Alias: "y"},
+					},
+				},
+			},
+			`
+			Query :: *sql.DB -> context.Context -> string -> ([]Row, error)
+			  CurriedQuery           :: func(*sql.DB) func(context.Context) func(string) ([]Row, error)
+			  CurriedQuery(db)       :: func(context.Context) func(string) ([]Row, error)
+			  CurriedQuery(db)(ctx)  :: func(string) ([]Row, error)
+
+			write :: io.Writer -> *y.Node -> ()
+			  CurriedWrite     :: func(io.Writer) func(*y.Node)
+			  CurriedWrite(w)  :: func(*y.Node)
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionExplainPresenter(&buf)
+
+			if err := p.Show(tt.out); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
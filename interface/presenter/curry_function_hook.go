@@ -0,0 +1,152 @@
+package presenter
+
+import (
+	"go/token"
+	"io"
+
+	"golang.org/x/xerrors"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// local variables in the inner most function
+const (
+	// hookStartName is the time when the original function is called.
+	hookStartName = "start"
+	// hookInterceptorName is the hook read once so that Before and After are called on the same interceptor.
+	hookInterceptorName = "interceptor"
+)
+
+type curryFunctionHookPresenter struct {
+	curryFunctionPresenter
+	// hook is the package-level hook.Interceptor variable in the output package
+	hook string
+}
+
+// NewCurryFunctionHookPresenter creates a new CurryFunctionOutputPort,
+// which calls Before and After of the hook.Interceptor variable named hook
+// around the original function in the inner most function.
+// The original function is called directly if the variable is nil.
+//
+//	func CurriedDiv(a int) func(int) (int, error) {
+//		return func(b int) (int, error) {
+//			interceptor := curryHook
+//			if interceptor == nil {
+//				return Div(a, b)
+//			}
+//			start := time.Now()
+//			interceptor.Before("Div", []interface{}{a, b})
+//			r0, r1 := Div(a, b)
+//			interceptor.After("Div", []interface{}{r0, r1}, time.Since(start))
+//			return r0, r1
+//		}
+//	}
+func NewCurryFunctionHookPresenter(
+	writer io.Writer,
+	hook string,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionHookPresenter{
+		curryFunctionPresenter: curryFunctionPresenter{writer: writer},
+		hook:                   hook,
+	}
+}
+
+// Show writes source code of curried functions calling the hook to p.writer.
+func (p *curryFunctionHookPresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	if len(out.Functions) == 0 {
+		return xerrors.Errorf("Functions must not be empty")
+	}
+
+	if !token.IsIdentifier(p.hook) {
+		return xerrors.Errorf("hook must be an identifier (got %q)", p.hook)
+	}
+
+	f := jen.NewFilePathName(
+		outputPackagePathOf(out.CurriedFunctionMetaData),
+		outputPackageNameOf(out.CurriedFunctionMetaData),
+	)
+
+	addHeaderComments(f, out.CurriedFunctionMetaData)
+	importNames := registerImports(f, out, []*usecase.ImportMetaData{{Path: "time", Name: "time"}})
+
+	code := jen.Null()
+	for i, fn := range out.Functions {
+		orig := p.originalFuncOf(fn, out.CurriedFunctionMetaData)
+
+		// NOTE: the hook and local variables are referred in the inner most function
+		extra := append(resultNames(fn.OriginalSignatureList.ReturnTypes()), p.hook, hookStartName, hookInterceptorName, importNames["time"])
+		needed := neededIdentifiers(fn, out.CurriedFunctionMetaData, importNames, extra...)
+		currySig, origSig := renameShadowingParams(fn.CurriedSignatureList, fn.OriginalSignatureList, needed)
+
+		partials := currySig.PartiallyAppliedSignatures
+		core := p.hookCoreCode(partials[len(partials)-1], origSig, orig)
+
+		if i > 0 {
+			code.Line().Line()
+		}
+		code.Add(docCode(fn.CurriedSignatureList.CurriedSignature.Name(), fn.OriginalSignatureList.Name(), fn.Doc))
+		code.Add(p.stagesCode(currySig, core))
+	}
+	f.Add(code)
+
+	if err := f.Render(p.writer); err != nil {
+		return xerrors.Errorf("failed to write code: %w", err)
+	}
+
+	return nil
+}
+
+func (p *curryFunctionHookPresenter) hookCoreCode(
+	sig *domain.FunctionSignature,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	fn := jen.Func().Params(renderParams(sig.Parameters())...)
+
+	if len(sig.ReturnTypes()) > 0 {
+		fn.Params(renderTypes(sig.ReturnTypes())...)
+	}
+
+	args := []jen.Code{}
+	for _, param := range origSig.Parameters() {
+		args = append(args, jen.Id(param.Name))
+	}
+
+	results := []jen.Code{}
+	for _, r := range resultNames(origSig.ReturnTypes()) {
+		results = append(results, jen.Id(r))
+	}
+
+	call := orig.call(renderParamValues(origSig.Parameters()))
+
+	// NOTE: the original function is called without the hook if it is nil
+	withoutHook := []jen.Code{jen.Return(call)}
+	if len(results) == 0 {
+		withoutHook = []jen.Code{call, jen.Return()}
+	}
+
+	resultsCode := jen.Nil()
+	if len(results) > 0 {
+		call = jen.List(results...).Op(":=").Add(call)
+		resultsCode = jen.Index().Interface().Values(results...)
+	}
+
+	name := jen.Lit(origSig.Name())
+	interceptor := jen.Id(hookInterceptorName)
+	body := []jen.Code{
+		jen.Add(interceptor).Op(":=").Id(p.hook),
+		jen.If(jen.Add(interceptor).Op("==").Nil()).Block(withoutHook...),
+		jen.Id(hookStartName).Op(":=").Qual("time", "Now").Call(),
+		jen.Add(interceptor).Dot("Before").Call(name, jen.Index().Interface().Values(args...)),
+		call,
+		jen.Add(interceptor).Dot("After").Call(name, resultsCode, jen.Qual("time", "Since").Call(jen.Id(hookStartName))),
+	}
+	if len(results) > 0 {
+		body = append(body, jen.Return(results...))
+	}
+
+	return fn.Block(body...)
+}
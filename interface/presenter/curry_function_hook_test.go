@@ -0,0 +1,206 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionHookPresenterShow(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       *usecase.CurriedFunctionOutputData
+		expected string
+	}{
+		{
+			"multiple returned values",
+			divOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "time"
+
+			// CurriedDiv is the curried form of Div.
+			func CurriedDiv(a int) func(int) (int, error) {
+				return func(b int) (int, error) {
+					interceptor := curryHook
+					if interceptor == nil {
+						return Div(a, b)
+					}
+					start := time.Now()
+					interceptor.Before("Div", []interface{}{a, b})
+					r0, r1 := Div(a, b)
+					interceptor.After("Div", []interface{}{r0, r1}, time.Since(start))
+					return r0, r1
+				}
+			}
+			`,
+		},
+		{
+			"arity 3 currying",
+			ternaryAddOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "time"
+
+			// CurriedAdd is the curried form of Add.
+			//
+			// Add adds ints.
+			func CurriedAdd(i1 int) func(int) func(int) int {
+				return func(i2 int) func(int) int {
+					return func(i3 int) int {
+						interceptor := curryHook
+						if interceptor == nil {
+							return Add(i1, i2, i3)
+						}
+						start := time.Now()
+						interceptor.Before("Add", []interface{}{i1, i2, i3})
+						r0 := Add(i1, i2, i3)
+						interceptor.After("Add", []interface{}{r0}, time.Since(start))
+						return r0
+					}
+				}
+			}
+			`,
+		},
+		{
+			"nothing is returned",
+			variadicOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "time"
+
+			// CurriedPrint is the curried form of print.
+			func CurriedPrint(w int) func([]string) {
+				return func(args []string) {
+					interceptor := curryHook
+					if interceptor == nil {
+						print(w, args...)
+						return
+					}
+					start := time.Now()
+					interceptor.Before("print", []interface{}{w, args})
+					print(w, args...)
+					interceptor.After("print", nil, time.Since(start))
+				}
+			}
+			`,
+		},
+		{
+			"parameters shadowing the hook and local variables",
+			returningOutputData("Join", "CurriedJoin",
+				domain.NewParameter("curryHook", domain.TermType("string")),
+				domain.NewParameter("start", domain.TermType("string")),
+				domain.TermType("string"),
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "time"
+
+			// CurriedJoin is the curried form of Join.
+			func CurriedJoin(curryHook1 string) func(string) string {
+				return func(start1 string) string {
+					interceptor := curryHook
+					if interceptor == nil {
+						return Join(curryHook1, start1)
+					}
+					start := time.Now()
+					interceptor.Before("Join", []interface{}{curryHook1, start1})
+					r0 := Join(curryHook1, start1)
+					interceptor.After("Join", []interface{}{r0}, time.Since(start))
+					return r0
+				}
+			}
+			`,
+		},
+		{
+			"parameter shadowing the interceptor",
+			returningOutputData("Join", "CurriedJoin",
+				domain.NewParameter("interceptor", domain.TermType("string")),
+				domain.NewParameter("sep", domain.TermType("string")),
+				domain.TermType("string"),
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "time"
+
+			// CurriedJoin is the curried form of Join.
+			func CurriedJoin(interceptor1 string) func(string) string {
+				return func(sep string) string {
+					interceptor := curryHook
+					if interceptor == nil {
+						return Join(interceptor1, sep)
+					}
+					start := time.Now()
+					interceptor.Before("Join", []interface{}{interceptor1, sep})
+					r0 := Join(interceptor1, sep)
+					interceptor.After("Join", []interface{}{r0}, time.Since(start))
+					return r0
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionHookPresenter(&buf, "curryHook")
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{tt.fn},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestCurryFunctionHookPresenterShowFailed(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewCurryFunctionHookPresenter(&buf, "obs.Hook")
+
+	err := p.Show(&usecase.CurryFunctionOutputData{
+		Functions: []*usecase.CurriedFunctionOutputData{ternaryAddOutputData},
+		CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+			PackageName: "mypackage",
+		},
+	})
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+
+	expected := `hook must be an identifier (got "obs.Hook")`
+	if !strings.Contains(err.Error(), expected) {
+		t.Errorf("wrong value: expected error containing %q, got %q", expected, err.Error())
+	}
+}
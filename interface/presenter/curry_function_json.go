@@ -0,0 +1,143 @@
+package presenter
+
+import (
+	"encoding/json"
+	"io"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+type curryFunctionJSONPresenter struct {
+	writer io.Writer
+}
+
+// NewCurryFunctionJSONPresenter creates a new CurryFunctionOutputPort,
+// which writes signatures of curried functions as JSON instead of source code.
+func NewCurryFunctionJSONPresenter(
+	writer io.Writer,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionJSONPresenter{
+		writer: writer,
+	}
+}
+
+type jsonOutput struct {
+	Package   *jsonPackage    `json:"package"`
+	Functions []*jsonFunction `json:"functions"`
+}
+
+type jsonPackage struct {
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	OutputName string `json:"outputName"`
+	OutputPath string `json:"outputPath"`
+}
+
+type jsonFunction struct {
+	Name        string `json:"name"`
+	CurriedName string `json:"curriedName"`
+	Doc         string `json:"doc,omitempty"`
+	// Signature is the signature of the original function.
+	Signature *jsonSignature `json:"signature"`
+	// Stages are signatures of the curried function and returned functions in order.
+	Stages []*jsonSignature `json:"stages"`
+}
+
+type jsonSignature struct {
+	Name    string           `json:"name"`
+	Params  []*jsonParameter `json:"params"`
+	Results []*jsonType      `json:"results"`
+}
+
+type jsonParameter struct {
+	Name string    `json:"name"`
+	Type *jsonType `json:"type"`
+}
+
+// Show writes signatures of curried functions to p.writer as JSON.
+func (p *curryFunctionJSONPresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	if len(out.Functions) == 0 {
+		return xerrors.Errorf("Functions must not be empty")
+	}
+
+	meta := out.CurriedFunctionMetaData
+	output := &jsonOutput{
+		Package: &jsonPackage{
+			Name:       meta.PackageName,
+			Path:       packagePathOf(meta),
+			OutputName: outputPackageNameOf(meta),
+			OutputPath: outputPackagePathOf(meta),
+		},
+		Functions: []*jsonFunction{},
+	}
+
+	for _, fn := range out.Functions {
+		f, err := p.function(fn)
+		if err != nil {
+			return xerrors.Errorf("failed to convert %s: %w", fn.OriginalSignatureList.Name(), err)
+		}
+		output.Functions = append(output.Functions, f)
+	}
+
+	enc := json.NewEncoder(p.writer)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(output); err != nil {
+		return xerrors.Errorf("failed to write json: %w", err)
+	}
+
+	return nil
+}
+
+func (p *curryFunctionJSONPresenter) function(fn *usecase.CurriedFunctionOutputData) (*jsonFunction, error) {
+	sig, err := p.signature(fn.OriginalSignatureList)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := append(
+		[]*domain.FunctionSignature{fn.CurriedSignatureList.CurriedSignature},
+		fn.CurriedSignatureList.PartiallyAppliedSignatures...,
+	)
+
+	stages := make([]*jsonSignature, len(sigs))
+	for i, s := range sigs {
+		stage, err := p.signature(s)
+		if err != nil {
+			return nil, err
+		}
+		stages[i] = stage
+	}
+
+	return &jsonFunction{
+		Name:        fn.OriginalSignatureList.Name(),
+		CurriedName: fn.CurriedSignatureList.CurriedSignature.Name(),
+		Doc:         fn.Doc,
+		Signature:   sig,
+		Stages:      stages,
+	}, nil
+}
+
+func (p *curryFunctionJSONPresenter) signature(sig *domain.FunctionSignature) (*jsonSignature, error) {
+	params := []*jsonParameter{}
+	for _, param := range sig.Parameters() {
+		t, err := jsonTypeOf(param.Type)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, &jsonParameter{Name: param.Name, Type: t})
+	}
+
+	results, err := jsonTypesOf(sig.ReturnTypes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonSignature{
+		Name:    sig.Name(),
+		Params:  params,
+		Results: results,
+	}, nil
+}
@@ -0,0 +1,190 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionJSONPresenterShow(t *testing.T) {
+	tests := []struct {
+		name     string
+		out      *usecase.CurryFunctionOutputData
+		expected string
+	}{
+		{
+			"arity 2 currying",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					binaryFunctionOutputData("write", "CurriedWrite",
+						domain.NewParameter("w", domain.TermType("io.Writer")),
+						domain.NewParameter("b", domain.TermType("[]byte")),
+					),
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+					PackagePath: "example.com/mypackage",
+				},
+			},
+			`{
+				"package": {
+					"name": "mypackage",
+					"path": "example.com/mypackage",
+					"outputName": "mypackage",
+					"outputPath": "example.com/mypackage"
+				},
+				"functions": [
+					{
+						"name": "write",
+						"curriedName": "CurriedWrite",
+						"signature": {
+							"name": "write",
+							"params": [
+								{"name": "w", "type": {"kind": "named", "package": "io", "name": "Writer"}},
+								{"name": "b", "type": {"kind": "slice", "elem": {"kind": "builtin", "name": "byte"}}}
+							],
+							"results": []
+						},
+						"stages": [
+							{
+								"name": "CurriedWrite",
+								"params": [
+									{"name": "w", "type": {"kind": "named", "package": "io", "name": "Writer"}}
+								],
+								"results": [
+									{"kind": "func", "params": [{"kind": "slice", "elem": {"kind": "builtin", "name": "byte"}}]}
+								]
+							},
+							{
+								"name": "write1",
+								"params": [
+									{"name": "b", "type": {"kind": "slice", "elem": {"kind": "builtin", "name": "byte"}}}
+								],
+								"results": []
+							}
+						]
+					}
+				]
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionJSONPresenter(&buf)
+
+			if err := p.Show(tt.out); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			var actual, expected interface{}
+			if err := json.Unmarshal(buf.Bytes(), &actual); err != nil {
+				t.Fatalf("output must be json: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.expected), &expected); err != nil {
+				t.Fatalf("expected must be json: %v", err)
+			}
+
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("wrong value: expected %s, got %s", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestParseJSONType(t *testing.T) {
+	tests := []struct {
+		name     string
+		typ      string
+		expected *jsonType
+	}{
+		{
+			"builtin",
+			"int",
+			&jsonType{Kind: "builtin", Name: "int"},
+		},
+		{
+			"qualified",
+			"gopkg.in/yaml.v3.Node",
+			&jsonType{Kind: "named", Package: "gopkg.in/yaml.v3", Name: "Node"},
+		},
+		{
+			"pointer",
+			"*example.com/p.Point",
+			&jsonType{Kind: "pointer", Elem: &jsonType{Kind: "named", Package: "example.com/p", Name: "Point"}},
+		},
+		{
+			"array",
+			"[3]string",
+			&jsonType{Kind: "array", Len: "3", Elem: &jsonType{Kind: "builtin", Name: "string"}},
+		},
+		{
+			"map",
+			"map[string]io.Writer",
+			&jsonType{
+				Kind: "map",
+				Key:  &jsonType{Kind: "builtin", Name: "string"},
+				Elem: &jsonType{Kind: "named", Package: "io", Name: "Writer"},
+			},
+		},
+		{
+			"receive-only channel",
+			"<-chan error",
+			&jsonType{Kind: "chan", Dir: "recv", Elem: &jsonType{Kind: "builtin", Name: "error"}},
+		},
+		{
+			"variadic func",
+			"func(string, ...int) (bool, error)",
+			&jsonType{
+				Kind: "func",
+				Params: []*jsonType{
+					{Kind: "builtin", Name: "string"},
+					{Kind: "slice", Elem: &jsonType{Kind: "builtin", Name: "int"}},
+				},
+				Results: []*jsonType{
+					{Kind: "builtin", Name: "bool"},
+					{Kind: "builtin", Name: "error"},
+				},
+				Variadic: true,
+			},
+		},
+		{
+			"generic type",
+			"example.com/p.Pair[string, io.Reader]",
+			&jsonType{
+				Kind:    "named",
+				Package: "example.com/p",
+				Name:    "Pair",
+				TypeArgs: []*jsonType{
+					{Kind: "builtin", Name: "string"},
+					{Kind: "named", Package: "io", Name: "Reader"},
+				},
+			},
+		},
+		{
+			"interface",
+			"interface{Write(p []byte) (n int, err error)}",
+			&jsonType{Kind: "interface", Text: "interface {\n\tWrite(p []byte) (n int, err error)\n}"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := parseJSONType(tt.typ)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				a, _ := json.Marshal(actual)
+				e, _ := json.Marshal(tt.expected)
+				t.Errorf("wrong value: expected %s, got %s", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/usecase"
+)
+
+type curryFunctionListPresenter struct {
+	writer io.Writer
+}
+
+// NewCurryFunctionListPresenter creates a new CurryFunctionOutputPort,
+// which lists curryable functions instead of writing source code.
+func NewCurryFunctionListPresenter(
+	writer io.Writer,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionListPresenter{
+		writer: writer,
+	}
+}
+
+// Show writes name, arity and curried name of each function to p.writer.
+func (p *curryFunctionListPresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	w := tabwriter.NewWriter(p.writer, 0, 4, 2, ' ', 0)
+
+	for _, fn := range out.Functions {
+		fmt.Fprintf(w, "%s\t%d\t%s\n",
+			fn.OriginalSignatureList.Name(),
+			fn.OriginalSignatureList.Arity(),
+			fn.CurriedSignatureList.CurriedSignature.Name(),
+		)
+	}
+
+	if err := w.Flush(); err != nil {
+		return xerrors.Errorf("failed to write list: %w", err)
+	}
+
+	return nil
+}
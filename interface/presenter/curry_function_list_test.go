@@ -0,0 +1,81 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionListPresenterShow(t *testing.T) {
+	tests := []struct {
+		name     string
+		out      *usecase.CurryFunctionOutputData
+		expected string
+	}{
+		{
+			"list functions",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"add",
+							[]domain.Parameter{
+								domain.NewParameter("i1", domain.TermType("int")),
+								domain.NewParameter("i2", domain.TermType("int")),
+							},
+							[]domain.Type{domain.TermType("int")},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature("CurriedAdd", nil, nil),
+							nil,
+						),
+					},
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"concatenate",
+							[]domain.Parameter{
+								domain.NewParameter("s1", domain.TermType("string")),
+								domain.NewParameter("s2", domain.TermType("string")),
+								domain.NewParameter("s3", domain.TermType("string")),
+							},
+							[]domain.Type{domain.TermType("string")},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature("CurriedConcatenate", nil, nil),
+							nil,
+						),
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			},
+			`
+			add          2  CurriedAdd
+			concatenate  3  CurriedConcatenate
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionListPresenter(&buf)
+
+			if err := p.Show(tt.out); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
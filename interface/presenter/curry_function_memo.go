@@ -0,0 +1,194 @@
+package presenter
+
+import (
+	"fmt"
+	"io"
+	"unicode"
+
+	"golang.org/x/xerrors"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// memoPackagePath is the package of caches used in memoized curried functions.
+const memoPackagePath = "github.com/syuparn/chapati/memo"
+
+type curryFunctionMemoPresenter struct {
+	curryFunctionPresenter
+	// size is the max number of entries in each cache
+	size int
+}
+
+// NewCurryFunctionMemoPresenter creates a new CurryFunctionOutputPort,
+// which memoizes each stage returning a function by its argument
+// so that the same function is returned for the same argument.
+// Each cache holds at most size entries.
+//
+//	var curriedMatchMemo = memo.New(128)
+//
+//	func CurriedMatch(pattern string) func(string) bool {
+//		return curriedMatchMemo.Get(pattern, func() interface{} {
+//			return func(s string) bool {
+//				return Match(pattern, s)
+//			}
+//		}).(func(string) bool)
+//	}
+func NewCurryFunctionMemoPresenter(
+	writer io.Writer,
+	size int,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionMemoPresenter{
+		curryFunctionPresenter: curryFunctionPresenter{writer: writer},
+		size:                   size,
+	}
+}
+
+// Show writes source code of memoized curried functions to p.writer.
+func (p *curryFunctionMemoPresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	if len(out.Functions) == 0 {
+		return xerrors.Errorf("Functions must not be empty")
+	}
+
+	if p.size <= 0 {
+		return xerrors.Errorf("cache size must be positive (got %d)", p.size)
+	}
+
+	f := jen.NewFilePathName(
+		outputPackagePathOf(out.CurriedFunctionMetaData),
+		outputPackageNameOf(out.CurriedFunctionMetaData),
+	)
+
+	addHeaderComments(f, out.CurriedFunctionMetaData)
+	importNames := registerImports(f, out, []*usecase.ImportMetaData{{Path: memoPackagePath, Name: "memo"}})
+
+	code := jen.Null()
+	for i, fn := range out.Functions {
+		if err := checkMemoizable(fn, out.CurriedFunctionMetaData); err != nil {
+			return xerrors.Errorf("failed to memoize %s: %w", fn.OriginalSignatureList.Name(), err)
+		}
+
+		orig := p.originalFuncOf(fn, out.CurriedFunctionMetaData)
+
+		// NOTE: caches are referred in curried functions
+		extra := append(cacheNamesOf(fn), importNames[memoPackagePath])
+		needed := neededIdentifiers(fn, out.CurriedFunctionMetaData, importNames, extra...)
+		currySig, origSig := renameShadowingParams(fn.CurriedSignatureList, fn.OriginalSignatureList, needed)
+
+		if i > 0 {
+			code.Line().Line()
+		}
+		code.Add(p.cacheVarCode(fn))
+		code.Line().Line()
+		code.Add(docCode(fn.CurriedSignatureList.CurriedSignature.Name(), fn.OriginalSignatureList.Name(), fn.Doc))
+		code.Add(p.memoCode(fn, currySig, origSig, orig))
+	}
+	f.Add(code)
+
+	if err := f.Render(p.writer); err != nil {
+		return xerrors.Errorf("failed to write code: %w", err)
+	}
+
+	return nil
+}
+
+// checkMemoizable checks all parameters of stages returning functions can be keys of caches.
+func checkMemoizable(fn *usecase.CurriedFunctionOutputData, meta usecase.CurriedFunctionMetaData) error {
+	incomparable := map[string]bool{}
+	for _, name := range fn.IncomparableParams {
+		incomparable[name] = true
+	}
+
+	// NOTE: the last stage is not memoized because it returns results of the original function
+	stages := append([]*domain.FunctionSignature{fn.CurriedSignatureList.CurriedSignature},
+		fn.CurriedSignatureList.PartiallyAppliedSignatures...)
+	for _, stage := range stages[:len(stages)-1] {
+		for _, param := range stage.Parameters() {
+			if incomparable[param.Name] {
+				return xerrors.Errorf("parameter %s cannot be a cache key because %s is not comparable",
+					param.Name, newTypeQualifier(meta).text(param.Type))
+			}
+		}
+	}
+
+	return nil
+}
+
+// cacheVarName returns the name of the package-level cache of the curried function.
+func cacheVarName(fn *usecase.CurriedFunctionOutputData) string {
+	runes := []rune(fn.CurriedSignatureList.CurriedSignature.Name())
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes) + "Memo"
+}
+
+// cacheLocalName returns the name of the cache of the n-th partially applied function.
+func cacheLocalName(n int) string {
+	return fmt.Sprintf("memo%d", n)
+}
+
+func cacheNamesOf(fn *usecase.CurriedFunctionOutputData) []string {
+	names := []string{cacheVarName(fn)}
+	for n := 1; n < len(fn.CurriedSignatureList.PartiallyAppliedSignatures); n++ {
+		names = append(names, cacheLocalName(n))
+	}
+	return names
+}
+
+func (p *curryFunctionMemoPresenter) cacheVarCode(fn *usecase.CurriedFunctionOutputData) jen.Code {
+	name := cacheVarName(fn)
+	return jen.Comment(fmt.Sprintf("%s memoizes %s by the first argument.", name, fn.CurriedSignatureList.CurriedSignature.Name())).
+		Line().
+		Var().Id(name).Op("=").Qual(memoPackagePath, "New").Call(jen.Lit(p.size))
+}
+
+func (p *curryFunctionMemoPresenter) memoCode(
+	fn *usecase.CurriedFunctionOutputData,
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	partials := currySig.PartiallyAppliedSignatures
+
+	// inner most function
+	code := p.curryCoreCode(partials[len(partials)-1], origSig, orig)
+
+	// inner functions from inner to outer (the n-th function has the cache memo{n})
+	for n := len(partials) - 1; n >= 1; n-- {
+		sig := partials[n-1]
+		get := p.cacheGetCode(jen.Id(cacheLocalName(n)), sig, code, n < len(partials)-1, n+1)
+		code = jen.Func().Params(renderParams(sig.Parameters())...).
+			Params(renderTypes(sig.ReturnTypes())...).
+			Block(jen.Return(get))
+	}
+
+	// outer function
+	outer := currySig.CurriedSignature
+	get := p.cacheGetCode(jen.Id(cacheVarName(fn)), outer, code, len(partials) > 1, 1)
+	return jen.Func().Id(outer.Name()).
+		Params(renderParams(outer.Parameters())...).
+		Params(renderTypes(outer.ReturnTypes())...).
+		Block(jen.Return(get))
+}
+
+// cacheGetCode gets the function returned by sig from the cache, or creates it by inner.
+// If hasNext is true, the cache of the next function is also created.
+func (p *curryFunctionMemoPresenter) cacheGetCode(
+	cache jen.Code,
+	sig *domain.FunctionSignature,
+	inner jen.Code,
+	hasNext bool,
+	next int,
+) jen.Code {
+	body := []jen.Code{}
+	if hasNext {
+		body = append(body, jen.Id(cacheLocalName(next)).Op(":=").Qual(memoPackagePath, "New").Call(jen.Lit(p.size)))
+	}
+	body = append(body, jen.Return(inner))
+
+	return jen.Add(cache).Dot("Get").Call(
+		jen.Id(sig.Parameters()[0].Name),
+		jen.Func().Params().Interface().Block(body...),
+	).Assert(renderType(sig.ReturnTypes()[0]))
+}
@@ -0,0 +1,176 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionMemoPresenterShow(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       *usecase.CurriedFunctionOutputData
+		expected string
+	}{
+		{
+			"arity 2 currying",
+			binaryFunctionOutputData("match", "CurriedMatch",
+				domain.NewParameter("pattern", domain.TermType("string")),
+				domain.NewParameter("s", domain.TermType("string")),
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "github.com/syuparn/chapati/memo"
+
+			// curriedMatchMemo memoizes CurriedMatch by the first argument.
+			var curriedMatchMemo = memo.New(16)
+
+			// CurriedMatch is the curried form of match.
+			func CurriedMatch(pattern string) func(string) {
+				return curriedMatchMemo.Get(pattern, func() interface{} {
+					return func(s string) {
+						match(pattern, s)
+					}
+				}).(func(string))
+			}
+			`,
+		},
+		{
+			"arity 3 currying",
+			ternaryAddOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "github.com/syuparn/chapati/memo"
+
+			// curriedAddMemo memoizes CurriedAdd by the first argument.
+			var curriedAddMemo = memo.New(16)
+
+			// CurriedAdd is the curried form of Add.
+			//
+			// Add adds ints.
+			func CurriedAdd(i1 int) func(int) func(int) int {
+				return curriedAddMemo.Get(i1, func() interface{} {
+					memo1 := memo.New(16)
+					return func(i2 int) func(int) int {
+						return memo1.Get(i2, func() interface{} {
+							return func(i3 int) int {
+								return Add(i1, i2, i3)
+							}
+						}).(func(int) int)
+					}
+				}).(func(int) func(int) int)
+			}
+			`,
+		},
+		{
+			"incomparable last parameter",
+			func() *usecase.CurriedFunctionOutputData {
+				fn := *variadicOutputData
+				fn.IncomparableParams = []string{"args"}
+				return &fn
+			}(),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "github.com/syuparn/chapati/memo"
+
+			// curriedPrintMemo memoizes CurriedPrint by the first argument.
+			var curriedPrintMemo = memo.New(16)
+
+			// CurriedPrint is the curried form of print.
+			func CurriedPrint(w int) func([]string) {
+				return curriedPrintMemo.Get(w, func() interface{} {
+					return func(args []string) {
+						print(w, args...)
+					}
+				}).(func([]string))
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionMemoPresenter(&buf, 16)
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{tt.fn},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestCurryFunctionMemoPresenterShowFailed(t *testing.T) {
+	incomparable := binaryFunctionOutputData("join", "CurriedJoin",
+		domain.NewParameter("elems", domain.TermType("[]string")),
+		domain.NewParameter("sep", domain.TermType("string")),
+	)
+	incomparable.IncomparableParams = []string{"elems"}
+
+	tests := []struct {
+		name     string
+		size     int
+		fn       *usecase.CurriedFunctionOutputData
+		expected string
+	}{
+		{
+			"incomparable parameter",
+			16,
+			incomparable,
+			"parameter elems cannot be a cache key because []string is not comparable",
+		},
+		{
+			"invalid size",
+			0,
+			ternaryAddOutputData,
+			"cache size must be positive (got 0)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionMemoPresenter(&buf, tt.size)
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{tt.fn},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			})
+			if err == nil {
+				t.Fatalf("error must not be nil")
+			}
+
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("wrong value: expected error containing %q, got %q", tt.expected, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package presenter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"golang.org/x/xerrors"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// applyMethodName is the method of partially applied struct types.
+const applyMethodName = "Apply"
+
+type curryFunctionStructPresenter struct {
+	curryFunctionPresenter
+}
+
+// NewCurryFunctionStructPresenter creates a new CurryFunctionOutputPort,
+// which writes struct types holding applied arguments instead of nested closures
+// so that partial application does not allocate.
+//
+//	func CurriedAdd(i1 int) AddP1 { return AddP1{i1: i1} }
+//	type AddP1 struct{ i1 int }
+//	func (p AddP1) Apply(i2 int) int { return Add(p.i1, i2) }
+func NewCurryFunctionStructPresenter(
+	writer io.Writer,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionStructPresenter{
+		curryFunctionPresenter{writer: writer},
+	}
+}
+
+// Show writes source code of curried functions and struct types to p.writer.
+func (p *curryFunctionStructPresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	if len(out.Functions) == 0 {
+		return xerrors.Errorf("Functions must not be empty")
+	}
+
+	f := jen.NewFilePathName(
+		outputPackagePathOf(out.CurriedFunctionMetaData),
+		outputPackageNameOf(out.CurriedFunctionMetaData),
+	)
+
+	addHeaderComments(f, out.CurriedFunctionMetaData)
+	importNames := registerImports(f, out, nil)
+
+	code := jen.Null()
+	for i, fn := range out.Functions {
+		orig := p.originalFuncOf(fn, out.CurriedFunctionMetaData)
+
+		// NOTE: parameters are also fields of struct types referred in methods
+		needed := neededIdentifiers(fn, out.CurriedFunctionMetaData, importNames, partialTypeNamesOf(fn)...)
+		currySig, origSig := renameShadowingParams(fn.CurriedSignatureList, fn.OriginalSignatureList, needed)
+
+		if i > 0 {
+			code.Line().Line()
+		}
+		code.Add(docCode(fn.CurriedSignatureList.CurriedSignature.Name(), fn.OriginalSignatureList.Name(), fn.Doc))
+		code.Add(p.structCode(fn, currySig, origSig, orig))
+	}
+	f.Add(code)
+
+	if err := f.Render(p.writer); err != nil {
+		return xerrors.Errorf("failed to write code: %w", err)
+	}
+
+	return nil
+}
+
+// partialTypeName returns the name of the struct type holding n applied arguments
+// (exported if the curried function is exported).
+func partialTypeName(fn *usecase.CurriedFunctionOutputData, n int) string {
+	name := fn.OriginalSignatureList.Name()
+	curriedName := fn.CurriedSignatureList.CurriedSignature.Name()
+
+	runes := []rune(name)
+	if unicode.IsUpper([]rune(curriedName)[0]) {
+		runes[0] = unicode.ToUpper(runes[0])
+	} else {
+		runes[0] = unicode.ToLower(runes[0])
+	}
+
+	return fmt.Sprintf("%sP%d", string(runes), n)
+}
+
+func partialTypeNamesOf(fn *usecase.CurriedFunctionOutputData) []string {
+	names := []string{}
+	for n := 1; n <= len(fn.CurriedSignatureList.PartiallyAppliedSignatures); n++ {
+		names = append(names, partialTypeName(fn, n))
+	}
+	return names
+}
+
+func (p *curryFunctionStructPresenter) structCode(
+	fn *usecase.CurriedFunctionOutputData,
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	// parameters in the curried order
+	params := []domain.Parameter{currySig.CurriedSignature.Parameters()[0]}
+	for _, sig := range currySig.PartiallyAppliedSignatures {
+		params = append(params, sig.Parameters()[0])
+	}
+
+	// NOTE: receiver must not conflict with parameters
+	used := map[string]bool{}
+	for _, param := range params {
+		used[param.Name] = true
+	}
+	recv := "p"
+	if used[recv] {
+		recv = uniqueName(recv, used)
+	}
+
+	first := params[0]
+	code := jen.Func().Id(currySig.CurriedSignature.Name()).
+		Params(renderParam(first)).
+		Id(partialTypeName(fn, 1)).
+		Block(
+			jen.Return(jen.Id(partialTypeName(fn, 1)).Values(jen.Dict{jen.Id(first.Name): jen.Id(first.Name)})),
+		).
+		Line()
+
+	for n := 1; n < len(params); n++ {
+		typeName := partialTypeName(fn, n)
+		applied, next := params[:n], params[n]
+
+		fields := make([]jen.Code, len(applied))
+		for i, param := range applied {
+			fields[i] = renderParam(param)
+		}
+
+		code.Line().
+			Comment(fmt.Sprintf("%s is %s partially applied with %s.", typeName, origSig.Name(), paramNamesOf(applied))).
+			Line().
+			Type().Id(typeName).Struct(fields...).
+			Line().
+			Line().
+			Comment(fmt.Sprintf("%s applies %s to %s.", applyMethodName, next.Name, origSig.Name())).
+			Line().
+			Func().Params(jen.Id(recv).Id(typeName)).Id(applyMethodName).
+			Params(renderParam(next)).
+			Add(p.applyResultCode(fn, currySig, n)).
+			Block(p.applyBodyCode(fn, origSig, params, n, recv, orig)).
+			Line()
+	}
+
+	return code
+}
+
+// applyResultCode renders returned types of Apply of the struct type holding n arguments.
+func (p *curryFunctionStructPresenter) applyResultCode(
+	fn *usecase.CurriedFunctionOutputData,
+	currySig *domain.CurriedSignatureList,
+	n int,
+) jen.Code {
+	if n < len(currySig.PartiallyAppliedSignatures) {
+		return jen.Id(partialTypeName(fn, n+1))
+	}
+
+	returnTypes := currySig.PartiallyAppliedSignatures[n-1].ReturnTypes()
+	switch len(returnTypes) {
+	case 0:
+		return jen.Null()
+	case 1:
+		return renderType(returnTypes[0])
+	default:
+		types := make([]jen.Code, len(returnTypes))
+		for i, t := range returnTypes {
+			types[i] = renderType(t)
+		}
+		return jen.Parens(jen.List(types...))
+	}
+}
+
+func (p *curryFunctionStructPresenter) applyBodyCode(
+	fn *usecase.CurriedFunctionOutputData,
+	origSig *domain.FunctionSignature,
+	params []domain.Parameter,
+	n int,
+	recv string,
+	orig originalFunc,
+) jen.Code {
+	next := params[n]
+
+	if n < len(params)-1 {
+		values := jen.Dict{jen.Id(next.Name): jen.Id(next.Name)}
+		for _, param := range params[:n] {
+			values[jen.Id(param.Name)] = jen.Id(recv).Dot(param.Name)
+		}
+		return jen.Return(jen.Id(partialTypeName(fn, n+1)).Values(values))
+	}
+
+	// NOTE: the original function is called in the original order
+	args := []jen.Code{}
+	for _, param := range origSig.Parameters() {
+		var arg *jen.Statement
+		if param.Name == next.Name {
+			arg = jen.Id(param.Name)
+		} else {
+			arg = jen.Id(recv).Dot(param.Name)
+		}
+		args = append(args, arg)
+	}
+	call := orig.call(args)
+
+	// NOTE: function without return values cannot be returned
+	if len(origSig.ReturnTypes()) == 0 {
+		return call
+	}
+	return jen.Return(call)
+}
+
+func paramNamesOf(params []domain.Parameter) string {
+	names := make([]string, len(params))
+	for i, param := range params {
+		names[i] = param.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,223 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// ternaryAddOutputData is the output data of Add(i1, i2, i3 int) int.
+var ternaryAddOutputData = &usecase.CurriedFunctionOutputData{
+	OriginalSignatureList: domain.NewFunctionSignature(
+		"Add",
+		[]domain.Parameter{
+			domain.NewParameter("i1", domain.TermType("int")),
+			domain.NewParameter("i2", domain.TermType("int")),
+			domain.NewParameter("i3", domain.TermType("int")),
+		},
+		[]domain.Type{domain.TermType("int")},
+	),
+	CurriedSignatureList: domain.NewCurriedSignatureList(
+		domain.NewFunctionSignature(
+			"CurriedAdd",
+			[]domain.Parameter{domain.NewParameter("i1", domain.TermType("int"))},
+			[]domain.Type{domain.NewFuncType(
+				[]domain.Type{domain.TermType("int")},
+				[]domain.Type{domain.NewFuncType(
+					[]domain.Type{domain.TermType("int")},
+					[]domain.Type{domain.TermType("int")},
+				)},
+			)},
+		),
+		[]*domain.FunctionSignature{
+			domain.NewFunctionSignature(
+				"Add1",
+				[]domain.Parameter{domain.NewParameter("i2", domain.TermType("int"))},
+				[]domain.Type{domain.NewFuncType(
+					[]domain.Type{domain.TermType("int")},
+					[]domain.Type{domain.TermType("int")},
+				)},
+			),
+			domain.NewFunctionSignature(
+				"Add2",
+				[]domain.Parameter{domain.NewParameter("i3", domain.TermType("int"))},
+				[]domain.Type{domain.TermType("int")},
+			),
+		},
+	),
+	Doc: "Add adds ints.\n",
+}
+
+func TestCurryFunctionStructPresenterShow(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       *usecase.CurriedFunctionOutputData
+		expected string
+	}{
+		{
+			"arity 3 currying",
+			ternaryAddOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedAdd is the curried form of Add.
+			//
+			// Add adds ints.
+			func CurriedAdd(i1 int) AddP1 {
+				return AddP1{i1: i1}
+			}
+
+			// AddP1 is Add partially applied with i1.
+			type AddP1 struct {
+				i1 int
+			}
+
+			// Apply applies i2 to Add.
+			func (p AddP1) Apply(i2 int) AddP2 {
+				return AddP2{
+					i1: p.i1,
+					i2: i2,
+				}
+			}
+
+			// AddP2 is Add partially applied with i1, i2.
+			type AddP2 struct {
+				i1 int
+				i2 int
+			}
+
+			// Apply applies i3 to Add.
+			func (p AddP2) Apply(i3 int) int {
+				return Add(p.i1, p.i2, i3)
+			}
+			`,
+		},
+		{
+			"variadic",
+			variadicOutputData,
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedPrint is the curried form of print.
+			func CurriedPrint(w int) PrintP1 {
+				return PrintP1{w: w}
+			}
+
+			// PrintP1 is print partially applied with w.
+			type PrintP1 struct {
+				w int
+			}
+
+			// Apply applies args to print.
+			func (p PrintP1) Apply(args []string) {
+				print(p.w, args...)
+			}
+			`,
+		},
+		{
+			"parameter conflicting with receiver",
+			binaryFunctionOutputData("parse", "curriedParse",
+				domain.NewParameter("p", domain.TermType("*example.com/mypackage.Parser")),
+				domain.NewParameter("r", domain.TermType("io.Reader")),
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "io"
+
+			// curriedParse is the curried form of parse.
+			func curriedParse(p *Parser) parseP1 {
+				return parseP1{p: p}
+			}
+
+			// parseP1 is parse partially applied with p.
+			type parseP1 struct {
+				p *Parser
+			}
+
+			// Apply applies r to parse.
+			func (p1 parseP1) Apply(r io.Reader) {
+				parse(p1.p, r)
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionStructPresenter(&buf)
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{tt.fn},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+					PackagePath: "example.com/mypackage",
+				},
+			})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestCurryFunctionStructTestPresenterShow(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewCurryFunctionStructTestPresenter(&buf)
+
+	err := p.Show(&usecase.CurryFunctionOutputData{
+		Functions: []*usecase.CurriedFunctionOutputData{ternaryAddOutputData},
+		CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+			PackageName: "mypackage",
+		},
+	})
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	expected := strings.TrimPrefix(dedent.Dedent(`
+	// Code generated by chapati; DO NOT EDIT.
+
+	package mypackage
+
+	import (
+		"reflect"
+		"testing"
+		"testing/quick"
+	)
+
+	func TestCurriedAdd(t *testing.T) {
+		f := func(i1 int, i2 int, i3 int) bool {
+			actual0 := CurriedAdd(i1).Apply(i2).Apply(i3)
+			expected0 := Add(i1, i2, i3)
+			return reflect.DeepEqual(actual0, expected0)
+		}
+		if err := quick.Check(f, nil); err != nil {
+			t.Error(err)
+		}
+	}
+	`), "\n")
+
+	actual := buf.String()
+	if actual != expected {
+		t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+	}
+}
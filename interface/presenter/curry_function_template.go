@@ -0,0 +1,323 @@
+package presenter
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"strings"
+	"text/template"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+type curryFunctionTemplatePresenter struct {
+	writer   io.Writer
+	template *template.Template
+}
+
+// NewCurryFunctionTemplatePresenter creates a new CurryFunctionOutputPort,
+// which writes code rendered by tmpl instead of the default code.
+// tmpl must be parsed by ParseTemplate to use helper functions.
+func NewCurryFunctionTemplatePresenter(
+	writer io.Writer,
+	tmpl *template.Template,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionTemplatePresenter{
+		writer:   writer,
+		template: tmpl,
+	}
+}
+
+// ParseTemplate parses a template of generated code with helper functions below.
+// The template receives usecase.CurryFunctionOutputData, and the rendered code
+// follows the package clause and imports (which are added by the presenter).
+//
+//	type T        renders domain.Type T with package names
+//	params S      renders parameters of *domain.FunctionSignature S ("a int, b string")
+//	args S        renders parameter names of S ("a, b")
+//	results S     renders returned types of S ("", "int" or "(int, error)")
+//	qual P N      renders name N in the package whose import path is P ("io.Writer")
+//	callee F      renders the original function of *usecase.CurriedFunctionOutputData F
+//	body F        renders the default body of the curried function of F
+//	stages F      returns the curried signature and partially applied signatures of F
+//	comment TEXT  renders TEXT as line comments
+func ParseTemplate(name, text string) (*template.Template, error) {
+	// NOTE: placeholders are replaced in Show because helpers depend on imports of the output
+	tmpl, err := template.New(name).Funcs((&templateRenderer{}).funcMap()).Parse(text)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// Show writes source code rendered by the template to p.writer.
+func (p *curryFunctionTemplatePresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	if len(out.Functions) == 0 {
+		return xerrors.Errorf("Functions must not be empty")
+	}
+
+	imports := decideImports(out, nil)
+	r := newTemplateRenderer(out.CurriedFunctionMetaData, imports)
+
+	tmpl, err := p.template.Clone()
+	if err != nil {
+		return xerrors.Errorf("failed to clone template: %w", err)
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.Funcs(r.funcMap()).Execute(&body, r.renamed(out)); err != nil {
+		return xerrors.Errorf("failed to execute template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	r.writeHeader(&buf, imports)
+	buf.Write(body.Bytes())
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return xerrors.Errorf("rendered code cannot be formatted: %w\n%s", err, buf.String())
+	}
+
+	if _, err := p.writer.Write(code); err != nil {
+		return xerrors.Errorf("failed to write code: %w", err)
+	}
+
+	return nil
+}
+
+// templateRenderer renders code fragments in templates and records packages used in them.
+type templateRenderer struct {
+	meta usecase.CurriedFunctionMetaData
+	// names of imported packages
+	names map[string]string
+	// import paths used in rendered code
+	used map[string]bool
+}
+
+func newTemplateRenderer(
+	meta usecase.CurriedFunctionMetaData,
+	imports []*decidedImport,
+) *templateRenderer {
+	names := map[string]string{}
+	for _, imp := range imports {
+		names[imp.path] = imp.name
+	}
+
+	return &templateRenderer{
+		meta:  meta,
+		names: names,
+		used:  map[string]bool{},
+	}
+}
+
+func (r *templateRenderer) funcMap() template.FuncMap {
+	return template.FuncMap{
+		"type":    r.typeText,
+		"params":  r.params,
+		"args":    r.args,
+		"results": r.results,
+		"qual":    r.qual,
+		"callee":  r.callee,
+		"call":    r.call,
+		"body":    r.body,
+		"stages":  r.stages,
+		"comment": r.comment,
+	}
+}
+
+// renamed returns a copy of out whose parameters shadowing needed identifiers are renamed.
+func (r *templateRenderer) renamed(out *usecase.CurryFunctionOutputData) *usecase.CurryFunctionOutputData {
+	functions := make([]*usecase.CurriedFunctionOutputData, len(out.Functions))
+
+	for i, fn := range out.Functions {
+		needed := neededIdentifiers(fn, out.CurriedFunctionMetaData, r.names)
+		currySig, origSig := renameShadowingParams(fn.CurriedSignatureList, fn.OriginalSignatureList, needed)
+
+		functions[i] = &usecase.CurriedFunctionOutputData{
+			OriginalSignatureList: origSig,
+			CurriedSignatureList:  currySig,
+			Doc:                   fn.Doc,
+			Variadic:              fn.Variadic,
+			IncomparableParams:    fn.IncomparableParams,
+			CallArities:           fn.CallArities,
+		}
+	}
+
+	return &usecase.CurryFunctionOutputData{
+		Functions:               functions,
+		CurriedFunctionMetaData: out.CurriedFunctionMetaData,
+	}
+}
+
+// writeHeader writes the generated code header, package clause and imports used in rendered code.
+func (r *templateRenderer) writeHeader(w io.Writer, imports []*decidedImport) {
+	fmt.Fprintln(w, "// "+GeneratedCodeHeader)
+	for _, c := range r.meta.BuildConstraints {
+		fmt.Fprintln(w, c)
+	}
+	fmt.Fprintf(w, "\npackage %s\n\n", outputPackageNameOf(r.meta))
+
+	used := []*decidedImport{}
+	for _, imp := range imports {
+		if r.used[imp.path] {
+			used = append(used, imp)
+			delete(r.used, imp.path)
+		}
+	}
+	// NOTE: packages only used in the template (e.g. qual "log" "Printf")
+	for _, importPath := range sortedImportPaths(r.used, nil) {
+		used = append(used, &decidedImport{path: importPath, name: r.names[importPath]})
+	}
+	if len(used) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "import (")
+	for _, imp := range used {
+		if imp.aliased {
+			fmt.Fprintf(w, "\t%s %q\n", imp.name, imp.path)
+		} else {
+			fmt.Fprintf(w, "\t%q\n", imp.path)
+		}
+	}
+	fmt.Fprint(w, ")\n\n")
+}
+
+func (r *templateRenderer) qual(importPath, name string) string {
+	// NOTE: identifiers in the output package are not qualified
+	if importPath == outputPackagePathOf(r.meta) {
+		return name
+	}
+
+	r.used[importPath] = true
+
+	pkgName, ok := r.names[importPath]
+	if !ok {
+		pkgName = guessPackageName(importPath)
+		r.names[importPath] = pkgName
+	}
+	return pkgName + "." + name
+}
+
+func (r *templateRenderer) typeText(t domain.Type) string {
+	if !t.IsFuncType() {
+		return qualifiedNamePattern.ReplaceAllStringFunc(string(t.(domain.TermType)), func(name string) string {
+			m := qualifiedNamePattern.FindStringSubmatch(name)
+			return r.qual(m[1], m[2])
+		})
+	}
+
+	ft := t.(domain.FuncType)
+	return "func(" + r.typesText(ft.ParamTypes()) + ")" + r.resultsText(ft.ReturnTypes(), " ")
+}
+
+func (r *templateRenderer) typesText(types []domain.Type) string {
+	texts := make([]string, len(types))
+	for i, t := range types {
+		texts[i] = r.typeText(t)
+	}
+	return strings.Join(texts, ", ")
+}
+
+// resultsText renders returned types with prefix (nothing is rendered if there are no types).
+func (r *templateRenderer) resultsText(types []domain.Type, prefix string) string {
+	switch len(types) {
+	case 0:
+		return ""
+	case 1:
+		return prefix + r.typeText(types[0])
+	default:
+		return prefix + "(" + r.typesText(types) + ")"
+	}
+}
+
+func (r *templateRenderer) params(sig *domain.FunctionSignature) string {
+	params := make([]string, len(sig.Parameters()))
+	for i, p := range sig.Parameters() {
+		params[i] = p.Name + " " + r.typeText(p.Type)
+	}
+	return strings.Join(params, ", ")
+}
+
+func (r *templateRenderer) args(sig *domain.FunctionSignature) string {
+	args := make([]string, len(sig.Parameters()))
+	for i, p := range sig.Parameters() {
+		args[i] = p.Name
+	}
+	return strings.Join(args, ", ")
+}
+
+func (r *templateRenderer) results(sig *domain.FunctionSignature) string {
+	return r.resultsText(sig.ReturnTypes(), "")
+}
+
+func (r *templateRenderer) callee(fn *usecase.CurriedFunctionOutputData) string {
+	return r.qual(packagePathOf(r.meta), fn.OriginalSignatureList.Name())
+}
+
+// call renders the call of the original function with all arguments
+// (e.g. F(a, bs...), or Make(a)(b, c) in deep currying).
+func (r *templateRenderer) call(fn *usecase.CurriedFunctionOutputData) string {
+	args := make([]string, len(fn.OriginalSignatureList.Parameters()))
+	for i, p := range fn.OriginalSignatureList.Parameters() {
+		args[i] = p.Name
+	}
+	if fn.Variadic && len(args) > 0 {
+		args[len(args)-1] += "..."
+	}
+
+	arities := fn.CallArities
+	if len(arities) == 0 {
+		arities = []int{len(args)}
+	}
+
+	call := r.callee(fn)
+	for _, n := range arities {
+		call += "(" + strings.Join(args[:n], ", ") + ")"
+		args = args[n:]
+	}
+	return call
+}
+
+func (r *templateRenderer) stages(fn *usecase.CurriedFunctionOutputData) []*domain.FunctionSignature {
+	return append(
+		[]*domain.FunctionSignature{fn.CurriedSignatureList.CurriedSignature},
+		fn.CurriedSignatureList.PartiallyAppliedSignatures...,
+	)
+}
+
+// body renders the body of the curried function in the same way as the default presenter.
+func (r *templateRenderer) body(fn *usecase.CurriedFunctionOutputData) string {
+	partials := fn.CurriedSignatureList.PartiallyAppliedSignatures
+	origSig := fn.OriginalSignatureList
+
+	// NOTE: function without return values cannot be returned
+	inner := r.call(fn)
+	if len(origSig.ReturnTypes()) > 0 {
+		inner = "return " + inner
+	}
+
+	// inner functions from inner to outer
+	for i := len(partials) - 1; i >= 0; i-- {
+		sig := partials[i]
+		inner = fmt.Sprintf("return func(%s)%s {\n%s\n}", r.params(sig), r.resultsText(sig.ReturnTypes(), " "), inner)
+	}
+
+	return inner
+}
+
+func (r *templateRenderer) comment(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = "//"
+			continue
+		}
+		lines[i] = "// " + line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,255 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionTemplatePresenterShow(t *testing.T) {
+	write := binaryFunctionOutputData("write", "CurriedWrite",
+		domain.NewParameter("w", domain.TermType("io.Writer")),
+		domain.NewParameter("v", domain.TermType("*gopkg.in/yaml.v3.Node")),
+	)
+	write.Doc = "write writes v to w.\n"
+
+	tests := []struct {
+		name     string
+		template string
+		out      *usecase.CurryFunctionOutputData
+		expected string
+	}{
+		{
+			"var form",
+			`
+			{{range .Functions}}
+			{{$fn := .}}
+			{{with .CurriedSignatureList.CurriedSignature}}
+			{{comment .Name}}
+			var {{.Name}} = func({{params .}}) {{results .}} {
+				{{body $fn}}
+			}
+			{{end}}
+			{{end}}
+			`,
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					binaryFunctionOutputData("add", "CurriedAdd",
+						domain.NewParameter("a", domain.TermType("int")),
+						domain.NewParameter("b", domain.TermType("int")),
+					),
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedAdd
+			var CurriedAdd = func(a int) func(int) {
+				return func(b int) {
+					add(a, b)
+				}
+			}
+			`,
+		},
+		{
+			"qualified names and helpers",
+			`
+			{{range .Functions}}
+			{{comment .Doc}}
+			func {{.CurriedSignatureList.CurriedSignature.Name}}({{params .CurriedSignatureList.CurriedSignature}}) {{results .CurriedSignatureList.CurriedSignature}} {
+				{{qual "log" "Printf"}}("call {{.OriginalSignatureList.Name}}")
+				return func({{params (index (stages .) 1)}}) {
+					{{callee .}}({{args .OriginalSignatureList}})
+				}
+			}
+			{{end}}
+			`,
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{write},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName:       "mypackage",
+					PackagePath:       "example.com/mypackage",
+					OutputPackageName: "curried",
+					OutputPackagePath: "example.com/curried",
+					BuildConstraints:  []string{"//go:build linux"},
+					Imports: []*usecase.ImportMetaData{
+						{Path: "gopkg.in/yaml.v3", Alias: "y"},
+					},
+				},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+			//go:build linux
+
+			package curried
+
+			import (
+				"example.com/mypackage"
+				y "gopkg.in/yaml.v3"
+				"io"
+				"log"
+			)
+
+			// write writes v to w.
+			func CurriedWrite(w io.Writer) func(*y.Node) {
+				log.Printf("call write")
+				return func(v *y.Node) {
+					mypackage.write(w, v)
+				}
+			}
+			`,
+		},
+		{
+			"parameters shadowing packages are renamed",
+			`{{range .Functions}}func {{.CurriedSignatureList.CurriedSignature.Name}}({{params .CurriedSignatureList.CurriedSignature}}) {{results .CurriedSignatureList.CurriedSignature}} {
+				{{body .}}
+			}
+			{{end}}`,
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					binaryFunctionOutputData("write", "CurriedWrite",
+						domain.NewParameter("io", domain.TermType("int")),
+						domain.NewParameter("w", domain.TermType("io.Writer")),
+					),
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import (
+				"io"
+			)
+
+			func CurriedWrite(io1 int) func(io.Writer) {
+				return func(w io.Writer) {
+					write(io1, w)
+				}
+			}
+			`,
+		},
+		{
+			"call of returned functions",
+			`{{range .Functions}}func {{.CurriedSignatureList.CurriedSignature.Name}}({{params .CurriedSignatureList.CurriedSignature}}) {{results .CurriedSignatureList.CurriedSignature}} {
+				return func({{params (index (stages .) 1)}}) {
+					{{call .}}
+				}
+			}
+			{{end}}`,
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					func() *usecase.CurriedFunctionOutputData {
+						fn := *variadicOutputData
+						fn.CallArities = []int{1, 1}
+						return &fn
+					}(),
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			func CurriedPrint(w int) func([]string) {
+				return func(args []string) {
+					print(w)(args...)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate("test", dedent.Dedent(tt.template))
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			var buf bytes.Buffer
+			p := NewCurryFunctionTemplatePresenter(&buf, tmpl)
+
+			if err := p.Show(tt.out); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestCurryFunctionTemplatePresenterShowFailed(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			"unknown field",
+			"{{.Unknown}}",
+			"failed to execute template",
+		},
+		{
+			"invalid code",
+			"func {",
+			"rendered code cannot be formatted",
+		},
+	}
+
+	out := &usecase.CurryFunctionOutputData{
+		Functions: []*usecase.CurriedFunctionOutputData{
+			binaryFunctionOutputData("add", "CurriedAdd",
+				domain.NewParameter("a", domain.TermType("int")),
+				domain.NewParameter("b", domain.TermType("int")),
+			),
+		},
+		CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+			PackageName: "mypackage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate("test", tt.template)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			err = NewCurryFunctionTemplatePresenter(&bytes.Buffer{}, tmpl).Show(out)
+			if err == nil {
+				t.Fatalf("error must not be nil")
+			}
+
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("wrong value: expected error containing %q, got %q", tt.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestParseTemplateFailed(t *testing.T) {
+	if _, err := ParseTemplate("test", "{{unknown .}}"); err == nil {
+		t.Errorf("error must not be nil")
+	}
+}
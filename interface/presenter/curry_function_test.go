@@ -96,6 +96,7 @@ func TestCurryFunctionPresenterShow(t *testing.T) {
 
 			package mypackage
 
+			// curriedMyFunc is the curried form of myFunc.
 			func curriedMyFunc(arg0 string) func(int) error {
 				return func(arg1 int) error {
 					return myFunc(arg0, arg1)
@@ -152,6 +153,7 @@ func TestCurryFunctionPresenterShow(t *testing.T) {
 
 			import "io"
 
+			// curriedMyFunc is the curried form of myFunc.
 			func curriedMyFunc(arg0 io.Writer) func(int) error {
 				return func(arg1 int) error {
 					return myFunc(arg0, arg1)
@@ -206,8 +208,9 @@ func TestCurryFunctionPresenterShow(t *testing.T) {
 
 			package mypackage
 
-			import jen "github.com/dave/jennifer/jen"
+			import "github.com/dave/jennifer/jen"
 
+			// curriedMyFunc is the curried form of myFunc.
 			func curriedMyFunc(arg0 jen.Code) func(int) error {
 				return func(arg1 int) error {
 					return myFunc(arg0, arg1)
@@ -223,8 +226,12 @@ func TestCurryFunctionPresenterShow(t *testing.T) {
 			p := NewCurryFunctionPresenter(&buf)
 
 			err := p.Show(&usecase.CurryFunctionOutputData{
-				OriginalSignatureList: tt.origSig,
-				CurriedSignatureList:  tt.currySig,
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: tt.origSig,
+						CurriedSignatureList:  tt.currySig,
+					},
+				},
 				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
 					PackageName: tt.packageName,
 				},
@@ -244,6 +251,382 @@ func TestCurryFunctionPresenterShow(t *testing.T) {
 	}
 }
 
+// variadicOutputData is the output data of print(w int, args ...string).
+var variadicOutputData = func() *usecase.CurriedFunctionOutputData {
+	fn := binaryFunctionOutputData("print", "CurriedPrint",
+		domain.NewParameter("w", domain.TermType("int")),
+		domain.NewParameter("args", domain.TermType("[]string")),
+	)
+	fn.Variadic = true
+	return fn
+}()
+
+func TestCurryFunctionPresenterShowMultipleFunctions(t *testing.T) {
+	tests := []struct {
+		name     string
+		out      *usecase.CurryFunctionOutputData
+		expected string
+	}{
+		{
+			"multiple functions in package path",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"move",
+							[]domain.Parameter{
+								domain.NewParameter("p", domain.TermType("example.com/mypackage.Point")),
+								domain.NewParameter("d", domain.TermType("int")),
+							},
+							[]domain.Type{
+								domain.TermType("example.com/mypackage.Point"),
+							},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature(
+								"CurriedMove",
+								[]domain.Parameter{
+									domain.NewParameter("p", domain.TermType("example.com/mypackage.Point")),
+								},
+								[]domain.Type{
+									domain.NewFuncType(
+										[]domain.Type{domain.TermType("int")},
+										[]domain.Type{domain.TermType("example.com/mypackage.Point")},
+									),
+								},
+							),
+							[]*domain.FunctionSignature{
+								domain.NewFunctionSignature(
+									"move1",
+									[]domain.Parameter{
+										domain.NewParameter("d", domain.TermType("int")),
+									},
+									[]domain.Type{
+										domain.TermType("example.com/mypackage.Point"),
+									},
+								),
+							},
+						),
+					},
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"add",
+							[]domain.Parameter{
+								domain.NewParameter("i1", domain.TermType("int")),
+								domain.NewParameter("i2", domain.TermType("int")),
+							},
+							[]domain.Type{
+								domain.TermType("int"),
+							},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature(
+								"CurriedAdd",
+								[]domain.Parameter{
+									domain.NewParameter("i1", domain.TermType("int")),
+								},
+								[]domain.Type{
+									domain.NewFuncType(
+										[]domain.Type{domain.TermType("int")},
+										[]domain.Type{domain.TermType("int")},
+									),
+								},
+							),
+							[]*domain.FunctionSignature{
+								domain.NewFunctionSignature(
+									"add1",
+									[]domain.Parameter{
+										domain.NewParameter("i2", domain.TermType("int")),
+									},
+									[]domain.Type{
+										domain.TermType("int"),
+									},
+								),
+							},
+						),
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+					PackagePath: "example.com/mypackage",
+				},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedMove is the curried form of move.
+			func CurriedMove(p Point) func(int) Point {
+				return func(d int) Point {
+					return move(p, d)
+				}
+			}
+
+			// CurriedAdd is the curried form of add.
+			func CurriedAdd(i1 int) func(int) int {
+				return func(i2 int) int {
+					return add(i1, i2)
+				}
+			}
+			`,
+		},
+		{
+			"doc comments",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"Add",
+							[]domain.Parameter{
+								domain.NewParameter("i1", domain.TermType("int")),
+								domain.NewParameter("i2", domain.TermType("int")),
+							},
+							[]domain.Type{domain.TermType("int")},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature(
+								"CurriedAdd",
+								[]domain.Parameter{
+									domain.NewParameter("i1", domain.TermType("int")),
+								},
+								[]domain.Type{
+									domain.NewFuncType(
+										[]domain.Type{domain.TermType("int")},
+										[]domain.Type{domain.TermType("int")},
+									),
+								},
+							),
+							[]*domain.FunctionSignature{
+								domain.NewFunctionSignature(
+									"Add1",
+									[]domain.Parameter{
+										domain.NewParameter("i2", domain.TermType("int")),
+									},
+									[]domain.Type{domain.TermType("int")},
+								),
+							},
+						),
+						Doc: "Add returns the sum of i1 and i2.\n\nDeprecated: use Plus instead.\n",
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedAdd is the curried form of Add.
+			//
+			// Add returns the sum of i1 and i2.
+			//
+			// Deprecated: use Plus instead.
+			func CurriedAdd(i1 int) func(int) int {
+				return func(i2 int) int {
+					return Add(i1, i2)
+				}
+			}
+			`,
+		},
+		{
+			"build constraints",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"add",
+							[]domain.Parameter{
+								domain.NewParameter("i1", domain.TermType("int")),
+								domain.NewParameter("i2", domain.TermType("int")),
+							},
+							[]domain.Type{domain.TermType("int")},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature(
+								"CurriedAdd",
+								[]domain.Parameter{
+									domain.NewParameter("i1", domain.TermType("int")),
+								},
+								[]domain.Type{
+									domain.NewFuncType(
+										[]domain.Type{domain.TermType("int")},
+										[]domain.Type{domain.TermType("int")},
+									),
+								},
+							),
+							[]*domain.FunctionSignature{
+								domain.NewFunctionSignature(
+									"add1",
+									[]domain.Parameter{
+										domain.NewParameter("i2", domain.TermType("int")),
+									},
+									[]domain.Type{domain.TermType("int")},
+								),
+							},
+						),
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+					BuildConstraints: []string{
+						"//go:build linux && !386",
+						"// +build linux,!386",
+					},
+				},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+			//go:build linux && !386
+			// +build linux,!386
+
+			package mypackage
+
+			// CurriedAdd is the curried form of add.
+			func CurriedAdd(i1 int) func(int) int {
+				return func(i2 int) int {
+					return add(i1, i2)
+				}
+			}
+			`,
+		},
+		{
+			"variadic",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					variadicOutputData,
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedPrint is the curried form of print.
+			func CurriedPrint(w int) func([]string) {
+				return func(args []string) {
+					print(w, args...)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionPresenter(&buf)
+
+			if err := p.Show(tt.out); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestCurryFunctionPresenterShowOutputPackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		out      *usecase.CurryFunctionOutputData
+		expected string
+	}{
+		{
+			"reordered arguments in another package",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"Move",
+							[]domain.Parameter{
+								domain.NewParameter("p", domain.TermType("example.com/mypackage.Point")),
+								domain.NewParameter("d", domain.TermType("int")),
+							},
+							[]domain.Type{
+								domain.TermType("example.com/mypackage.Point"),
+							},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature(
+								"CurriedMove",
+								[]domain.Parameter{
+									domain.NewParameter("d", domain.TermType("int")),
+								},
+								[]domain.Type{
+									domain.NewFuncType(
+										[]domain.Type{domain.TermType("example.com/mypackage.Point")},
+										[]domain.Type{domain.TermType("example.com/mypackage.Point")},
+									),
+								},
+							),
+							[]*domain.FunctionSignature{
+								domain.NewFunctionSignature(
+									"Move1",
+									[]domain.Parameter{
+										domain.NewParameter("p", domain.TermType("example.com/mypackage.Point")),
+									},
+									[]domain.Type{
+										domain.TermType("example.com/mypackage.Point"),
+									},
+								),
+							},
+						),
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName:       "mypackage",
+					PackagePath:       "example.com/mypackage",
+					OutputPackageName: "curried",
+					OutputPackagePath: "example.com/curried",
+				},
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package curried
+
+			import "example.com/mypackage"
+
+			// CurriedMove is the curried form of Move.
+			func CurriedMove(d int) func(mypackage.Point) mypackage.Point {
+				return func(p mypackage.Point) mypackage.Point {
+					return mypackage.Move(p, d)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionPresenter(&buf)
+
+			if err := p.Show(tt.out); err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
 func TestCurryFunctionPresenterShowFailed(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -254,27 +637,38 @@ func TestCurryFunctionPresenterShowFailed(t *testing.T) {
 			"curriedSignatureList is not curry func",
 			"mypackage",
 			&usecase.CurryFunctionOutputData{
-				OriginalSignatureList: domain.NewFunctionSignature(
-					"myFunc",
-					[]domain.Parameter{
-						domain.NewParameter("arg0", domain.TermType("string")),
-					},
-					[]domain.Type{
-						domain.TermType("error"),
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: domain.NewFunctionSignature(
+							"myFunc",
+							[]domain.Parameter{
+								domain.NewParameter("arg0", domain.TermType("string")),
+							},
+							[]domain.Type{
+								domain.TermType("error"),
+							},
+						),
+						CurriedSignatureList: domain.NewCurriedSignatureList(
+							domain.NewFunctionSignature(
+								"nonCurriedMyFunc",
+								[]domain.Parameter{
+									domain.NewParameter("arg0", domain.TermType("string")),
+								},
+								[]domain.Type{
+									domain.TermType("error"),
+								},
+							),
+							[]*domain.FunctionSignature{},
+						),
 					},
-				),
-				CurriedSignatureList: domain.NewCurriedSignatureList(
-					domain.NewFunctionSignature(
-						"nonCurriedMyFunc",
-						[]domain.Parameter{
-							domain.NewParameter("arg0", domain.TermType("string")),
-						},
-						[]domain.Type{
-							domain.TermType("error"),
-						},
-					),
-					[]*domain.FunctionSignature{},
-				),
+				},
+			},
+		},
+		{
+			"no functions",
+			"mypackage",
+			&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{},
 			},
 		},
 	}
@@ -749,6 +1143,96 @@ func TestCurryFunctionPresenterCurryCode(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			"qualified names in compound types",
+			domain.NewFunctionSignature(
+				"myFunc",
+				[]domain.Parameter{
+					domain.NewParameter("arg0", domain.TermType("map[string]*io.Writer")),
+					domain.NewParameter("arg1", domain.TermType("func(gopkg.in/yaml.v3.Node) []github.com/dave/jennifer/jen.Code")),
+				},
+				[]domain.Type{
+					domain.TermType("chan io.Reader"),
+				},
+			),
+			domain.NewCurriedSignatureList(
+				domain.NewFunctionSignature(
+					"curriedMyFunc",
+					[]domain.Parameter{
+						domain.NewParameter("arg0", domain.TermType("map[string]*io.Writer")),
+					},
+					[]domain.Type{
+						domain.NewFuncType(
+							[]domain.Type{
+								domain.TermType("func(gopkg.in/yaml.v3.Node) []github.com/dave/jennifer/jen.Code"),
+							},
+							[]domain.Type{
+								domain.TermType("chan io.Reader"),
+							},
+						),
+					},
+				),
+				[]*domain.FunctionSignature{
+					domain.NewFunctionSignature(
+						"myFunc1",
+						[]domain.Parameter{
+							domain.NewParameter("arg1", domain.TermType("func(gopkg.in/yaml.v3.Node) []github.com/dave/jennifer/jen.Code")),
+						},
+						[]domain.Type{
+							domain.TermType("chan io.Reader"),
+						},
+					),
+				},
+			),
+			`
+			func curriedMyFunc(arg0 map[string]*io.Writer) func(func(yamlv3.Node) []jen.Code) chan io.Reader {
+				return func(arg1 func(yamlv3.Node) []jen.Code) chan io.Reader {
+					return myFunc(arg0, arg1)
+				}
+			}`,
+		},
+		{
+			"no return values",
+			domain.NewFunctionSignature(
+				"myFunc",
+				[]domain.Parameter{
+					domain.NewParameter("arg0", domain.TermType("string")),
+					domain.NewParameter("arg1", domain.TermType("int")),
+				},
+				[]domain.Type{},
+			),
+			domain.NewCurriedSignatureList(
+				domain.NewFunctionSignature(
+					"curriedMyFunc",
+					[]domain.Parameter{
+						domain.NewParameter("arg0", domain.TermType("string")),
+					},
+					[]domain.Type{
+						domain.NewFuncType(
+							[]domain.Type{
+								domain.TermType("int"),
+							},
+							[]domain.Type{},
+						),
+					},
+				),
+				[]*domain.FunctionSignature{
+					domain.NewFunctionSignature(
+						"myFunc1",
+						[]domain.Parameter{
+							domain.NewParameter("arg1", domain.TermType("int")),
+						},
+						[]domain.Type{},
+					),
+				},
+			),
+			`
+			func curriedMyFunc(arg0 string) func(int) {
+				return func(arg1 int) {
+					myFunc(arg0, arg1)
+				}
+			}`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -770,3 +1254,103 @@ func TestCurryFunctionPresenterCurryCode(t *testing.T) {
 		})
 	}
 }
+
+func TestCurryFunctionPresenterShowDeep(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       *usecase.CurriedFunctionOutputData
+		expected string
+	}{
+		{
+			"returned function",
+			func() *usecase.CurriedFunctionOutputData {
+				fn := *ternaryAddOutputData
+				fn.CallArities = []int{1, 2}
+				return &fn
+			}(),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedAdd is the curried form of Add.
+			//
+			// Add adds ints.
+			func CurriedAdd(i1 int) func(int) func(int) int {
+				return func(i2 int) func(int) int {
+					return func(i3 int) int {
+						return Add(i1)(i2, i3)
+					}
+				}
+			}
+			`,
+		},
+		{
+			"function without parameters",
+			func() *usecase.CurriedFunctionOutputData {
+				fn := *ternaryAddOutputData
+				fn.CallArities = []int{0, 1, 2}
+				return &fn
+			}(),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedAdd is the curried form of Add.
+			//
+			// Add adds ints.
+			func CurriedAdd(i1 int) func(int) func(int) int {
+				return func(i2 int) func(int) int {
+					return func(i3 int) int {
+						return Add()(i1)(i2, i3)
+					}
+				}
+			}
+			`,
+		},
+		{
+			"variadic returned function",
+			func() *usecase.CurriedFunctionOutputData {
+				fn := *variadicOutputData
+				fn.CallArities = []int{1, 1}
+				return &fn
+			}(),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedPrint is the curried form of print.
+			func CurriedPrint(w int) func([]string) {
+				return func(args []string) {
+					print(w)(args...)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionPresenter(&buf)
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{tt.fn},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
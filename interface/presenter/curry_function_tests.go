@@ -0,0 +1,359 @@
+package presenter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// testImports are packages imported by generated tests.
+var testImports = []*usecase.ImportMetaData{
+	{Path: "fmt", Name: "fmt"},
+	{Path: "reflect", Name: "reflect"},
+	{Path: "testing", Name: "testing"},
+	{Path: "testing/quick", Name: "quick"},
+}
+
+type curryFunctionTestPresenter struct {
+	curryFunctionPresenter
+	// whether curried functions are generated by NewCurryFunctionStructPresenter
+	structStyle bool
+}
+
+// NewCurryFunctionTestPresenter creates a new CurryFunctionOutputPort,
+// which writes tests to check each curried function is equivalent to the original one.
+func NewCurryFunctionTestPresenter(
+	writer io.Writer,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionTestPresenter{
+		curryFunctionPresenter: curryFunctionPresenter{writer: writer},
+	}
+}
+
+// NewCurryFunctionStructTestPresenter creates a new CurryFunctionOutputPort,
+// which writes tests of code generated by NewCurryFunctionStructPresenter.
+func NewCurryFunctionStructTestPresenter(
+	writer io.Writer,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionTestPresenter{
+		curryFunctionPresenter: curryFunctionPresenter{writer: writer},
+		structStyle:            true,
+	}
+}
+
+// Show writes source code of tests of curried functions to p.writer.
+func (p *curryFunctionTestPresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	if len(out.Functions) == 0 {
+		return xerrors.Errorf("Functions must not be empty")
+	}
+
+	f := jen.NewFilePathName(
+		outputPackagePathOf(out.CurriedFunctionMetaData),
+		outputPackageNameOf(out.CurriedFunctionMetaData),
+	)
+
+	addHeaderComments(f, out.CurriedFunctionMetaData)
+	importNames := registerImports(f, out, testImports)
+
+	for _, fn := range out.Functions {
+		orig := p.originalFuncOf(fn, out.CurriedFunctionMetaData)
+
+		// NOTE: parameters are declared in the function checked by testing/quick
+		needed := neededIdentifiers(fn, out.CurriedFunctionMetaData, importNames,
+			fn.CurriedSignatureList.CurriedSignature.Name(), importNames["fmt"], importNames["reflect"], "bool")
+		currySig, origSig := renameShadowingParams(fn.CurriedSignatureList, fn.OriginalSignatureList, needed)
+
+		f.Add(p.testCode(currySig, origSig, orig))
+	}
+
+	if err := f.Render(p.writer); err != nil {
+		return xerrors.Errorf("failed to write code: %w", err)
+	}
+
+	return nil
+}
+
+func (p *curryFunctionTestPresenter) testCode(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	for _, param := range origSig.Parameters() {
+		if !isQuickGeneratable(param.Type) {
+			return p.tableTestCode(currySig, origSig, orig)
+		}
+	}
+
+	return p.quickTestCode(currySig, origSig, orig)
+}
+
+// quickTestCode generates a test with testing/quick.
+//
+//	func TestCurriedF(t *testing.T) {
+//		f := func(a A, b B) bool {
+//			actual0 := CurriedF(a)(b)
+//			expected0 := F(a, b)
+//			return reflect.DeepEqual(actual0, expected0)
+//		}
+//		if err := quick.Check(f, nil); err != nil {
+//			t.Error(err)
+//		}
+//	}
+func (p *curryFunctionTestPresenter) quickTestCode(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	name := currySig.CurriedSignature.Name()
+	argValue := func(param domain.Parameter) jen.Code { return jen.Id(param.Name) }
+
+	body := p.callCode(currySig, origSig, orig, argValue)
+	body = append(body, jen.Return(p.equalCode(origSig.ReturnTypes())))
+
+	return jen.Func().Id("Test"+strings.Title(name)).
+		Params(jen.Id("t").Op("*").Qual("testing", "T")).
+		Block(
+			jen.Id("f").Op(":=").Func().
+				Params(renderParams(origSig.Parameters())...).
+				Bool().
+				Block(body...),
+			jen.If(
+				jen.Err().Op(":=").Qual("testing/quick", "Check").Call(jen.Id("f"), jen.Nil()),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Id("t").Dot("Error").Call(jen.Err()),
+			),
+		).
+		Line()
+}
+
+// tableTestCode generates a table-driven test whose cases must be written by hand.
+func (p *curryFunctionTestPresenter) tableTestCode(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+) jen.Code {
+	name := currySig.CurriedSignature.Name()
+	caseName := testCaseNameField(origSig.Parameters())
+	argValue := func(param domain.Parameter) jen.Code { return jen.Id("tt").Dot(param.Name) }
+
+	fields := []jen.Code{jen.Id(caseName).String()}
+	for _, param := range origSig.Parameters() {
+		fields = append(fields, renderParam(param))
+	}
+
+	body := p.callCode(currySig, origSig, orig, argValue)
+	if hasComparable(origSig.ReturnTypes()) {
+		body = append(body,
+			jen.If(jen.Op("!").Parens(p.equalCode(origSig.ReturnTypes()))).Block(
+				jen.Id("t").Dot("Errorf").Call(
+					jen.Lit(fmt.Sprintf("%s returned a different value from %s", name, origSig.Name())),
+				),
+			),
+		)
+	}
+
+	return jen.Func().Id("Test"+strings.Title(name)).
+		Params(jen.Id("t").Op("*").Qual("testing", "T")).
+		Block(
+			jen.Comment(fmt.Sprintf("TODO: add test cases (arguments of %s cannot be generated by testing/quick)", origSig.Name())),
+			jen.Id("tests").Op(":=").Index().Struct(fields...).Values(),
+			jen.Line(),
+			jen.For(jen.List(jen.Id("_"), jen.Id("tt")).Op(":=").Range().Id("tests")).Block(
+				jen.Id("t").Dot("Run").Call(
+					jen.Id("tt").Dot(caseName),
+					jen.Func().Params(jen.Id("t").Op("*").Qual("testing", "T")).Block(body...),
+				),
+			),
+		).
+		Line()
+}
+
+// callCode calls both the curried function and the original function.
+func (p *curryFunctionTestPresenter) callCode(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+	argValue func(domain.Parameter) jen.Code,
+) []jen.Code {
+	curried := p.curriedCallCode(currySig, argValue)
+	original := p.originalCallCode(origSig, orig, argValue)
+
+	returnTypes := origSig.ReturnTypes()
+	if !hasComparable(returnTypes) {
+		return []jen.Code{curried, original}
+	}
+
+	return []jen.Code{
+		jen.List(resultIds("actual", returnTypes)...).Op(":=").Add(curried),
+		jen.List(resultIds("expected", returnTypes)...).Op(":=").Add(original),
+	}
+}
+
+// curriedCallCode applies all arguments to the curried function.
+func (p *curryFunctionTestPresenter) curriedCallCode(
+	currySig *domain.CurriedSignatureList,
+	argValue func(domain.Parameter) jen.Code,
+) *jen.Statement {
+	curried := jen.Id(currySig.CurriedSignature.Name()).
+		Call(argValue(currySig.CurriedSignature.Parameters()[0]))
+	for _, sig := range currySig.PartiallyAppliedSignatures {
+		if p.structStyle {
+			curried.Dot(applyMethodName)
+		}
+		curried.Call(argValue(sig.Parameters()[0]))
+	}
+	return curried
+}
+
+// originalCallCode calls the original function.
+func (p *curryFunctionTestPresenter) originalCallCode(
+	origSig *domain.FunctionSignature,
+	orig originalFunc,
+	argValue func(domain.Parameter) jen.Code,
+) *jen.Statement {
+	origArgs := []jen.Code{}
+	for _, param := range origSig.Parameters() {
+		origArgs = append(origArgs, argValue(param))
+	}
+	return orig.call(origArgs)
+}
+
+// equalCode compares results of the curried function and the original function.
+func (p *curryFunctionTestPresenter) equalCode(returnTypes []domain.Type) jen.Code {
+	conds := []jen.Code{}
+
+	for i, t := range returnTypes {
+		actual := jen.Id(fmt.Sprintf("actual%d", i))
+		expected := jen.Id(fmt.Sprintf("expected%d", i))
+
+		switch {
+		case !isComparable(t):
+			// NOTE: functions and channels cannot be compared
+			continue
+		case t == domain.TermType("error"):
+			// NOTE: errors are compared by messages because they are newly created in each call
+			conds = append(conds, jen.Qual("fmt", "Sprint").Call(actual).Op("==").
+				Qual("fmt", "Sprint").Call(expected))
+		default:
+			conds = append(conds, jen.Qual("reflect", "DeepEqual").Call(actual, expected))
+		}
+	}
+
+	if len(conds) == 0 {
+		return jen.True()
+	}
+
+	code := jen.Add(conds[0])
+	for _, cond := range conds[1:] {
+		code.Op("&&").Add(cond)
+	}
+	return code
+}
+
+// resultIds returns identifiers of results (blank if they are not compared).
+func resultIds(prefix string, returnTypes []domain.Type) []jen.Code {
+	ids := make([]jen.Code, len(returnTypes))
+	for i, t := range returnTypes {
+		if !isComparable(t) {
+			ids[i] = jen.Id("_")
+			continue
+		}
+		ids[i] = jen.Id(fmt.Sprintf("%s%d", prefix, i))
+	}
+	return ids
+}
+
+// testCaseNameField returns a field name of test case names not conflicting with parameters.
+func testCaseNameField(params []domain.Parameter) string {
+	names := map[string]bool{}
+	for _, p := range params {
+		names[p.Name] = true
+	}
+
+	name := "name"
+	for i := 0; names[name]; i++ {
+		name = fmt.Sprintf("testName%d", i)
+	}
+	return name
+}
+
+func hasComparable(types []domain.Type) bool {
+	for _, t := range types {
+		if isComparable(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// isQuickGeneratable reports whether testing/quick can generate values of the type.
+func isQuickGeneratable(t domain.Type) bool {
+	if t.IsFuncType() {
+		return false
+	}
+	return isQuickGeneratableTypeString(string(t.(domain.TermType)))
+}
+
+func isQuickGeneratableTypeString(s string) bool {
+	switch s {
+	case "bool", "string", "byte", "rune",
+		"int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"float32", "float64", "complex64", "complex128":
+		return true
+	}
+
+	switch {
+	case strings.HasPrefix(s, "*"):
+		return isQuickGeneratableTypeString(s[1:])
+	case strings.HasPrefix(s, "[]"):
+		return isQuickGeneratableTypeString(s[2:])
+	case strings.HasPrefix(s, "["):
+		// array
+		i := strings.Index(s, "]")
+		return i != -1 && isQuickGeneratableTypeString(s[i+1:])
+	case strings.HasPrefix(s, "map["):
+		key, elem, ok := splitMapType(s)
+		return ok && isQuickGeneratableTypeString(key) && isQuickGeneratableTypeString(elem)
+	}
+
+	return false
+}
+
+// splitMapType splits "map[K]V" into K and V.
+func splitMapType(s string) (key, elem string, ok bool) {
+	depth := 0
+	for i := len("map"); i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return s[len("map["):i], s[i+1:], true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// isComparable reports whether values of the type can be compared by reflect.DeepEqual meaningfully.
+func isComparable(t domain.Type) bool {
+	if t.IsFuncType() {
+		return false
+	}
+
+	s := string(t.(domain.TermType))
+	return !strings.HasPrefix(s, "func(") &&
+		!strings.HasPrefix(s, "chan ") &&
+		!strings.HasPrefix(s, "<-chan ") &&
+		!strings.HasPrefix(s, "chan<- ")
+}
@@ -0,0 +1,219 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionTestPresenterShow(t *testing.T) {
+	tests := []struct {
+		name     string
+		origSig  *domain.FunctionSignature
+		currySig *domain.CurriedSignatureList
+		expected string
+	}{
+		{
+			"quick check",
+			domain.NewFunctionSignature(
+				"myFunc",
+				[]domain.Parameter{
+					domain.NewParameter("arg0", domain.TermType("string")),
+					domain.NewParameter("arg1", domain.TermType("map[string][]int")),
+				},
+				[]domain.Type{
+					domain.TermType("bool"),
+					domain.TermType("error"),
+				},
+			),
+			domain.NewCurriedSignatureList(
+				domain.NewFunctionSignature(
+					"curriedMyFunc",
+					[]domain.Parameter{
+						domain.NewParameter("arg0", domain.TermType("string")),
+					},
+					[]domain.Type{
+						domain.NewFuncType(
+							[]domain.Type{domain.TermType("map[string][]int")},
+							[]domain.Type{domain.TermType("bool"), domain.TermType("error")},
+						),
+					},
+				),
+				[]*domain.FunctionSignature{
+					domain.NewFunctionSignature(
+						"myFunc1",
+						[]domain.Parameter{
+							domain.NewParameter("arg1", domain.TermType("map[string][]int")),
+						},
+						[]domain.Type{
+							domain.TermType("bool"),
+							domain.TermType("error"),
+						},
+					),
+				},
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import (
+				"fmt"
+				"reflect"
+				"testing"
+				"testing/quick"
+			)
+
+			func TestCurriedMyFunc(t *testing.T) {
+				f := func(arg0 string, arg1 map[string][]int) bool {
+					actual0, actual1 := curriedMyFunc(arg0)(arg1)
+					expected0, expected1 := myFunc(arg0, arg1)
+					return reflect.DeepEqual(actual0, expected0) && fmt.Sprint(actual1) == fmt.Sprint(expected1)
+				}
+				if err := quick.Check(f, nil); err != nil {
+					t.Error(err)
+				}
+			}
+			`,
+		},
+		{
+			"table test",
+			domain.NewFunctionSignature(
+				"myFunc",
+				[]domain.Parameter{
+					domain.NewParameter("name", domain.TermType("io.Writer")),
+					domain.NewParameter("arg1", domain.TermType("int")),
+				},
+				[]domain.Type{
+					domain.TermType("func() int"),
+					domain.TermType("int"),
+				},
+			),
+			domain.NewCurriedSignatureList(
+				domain.NewFunctionSignature(
+					"curriedMyFunc",
+					[]domain.Parameter{
+						domain.NewParameter("name", domain.TermType("io.Writer")),
+					},
+					[]domain.Type{
+						domain.NewFuncType(
+							[]domain.Type{domain.TermType("int")},
+							[]domain.Type{domain.TermType("func() int"), domain.TermType("int")},
+						),
+					},
+				),
+				[]*domain.FunctionSignature{
+					domain.NewFunctionSignature(
+						"myFunc1",
+						[]domain.Parameter{
+							domain.NewParameter("arg1", domain.TermType("int")),
+						},
+						[]domain.Type{
+							domain.TermType("func() int"),
+							domain.TermType("int"),
+						},
+					),
+				},
+			),
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import (
+				"io"
+				"reflect"
+				"testing"
+			)
+
+			func TestCurriedMyFunc(t *testing.T) {
+				// TODO: add test cases (arguments of myFunc cannot be generated by testing/quick)
+				tests := []struct {
+					testName0 string
+					name      io.Writer
+					arg1      int
+				}{}
+
+				for _, tt := range tests {
+					t.Run(tt.testName0, func(t *testing.T) {
+						_, actual1 := curriedMyFunc(tt.name)(tt.arg1)
+						_, expected1 := myFunc(tt.name, tt.arg1)
+						if !(reflect.DeepEqual(actual1, expected1)) {
+							t.Errorf("curriedMyFunc returned a different value from myFunc")
+						}
+					})
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionTestPresenter(&buf)
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					{
+						OriginalSignatureList: tt.origSig,
+						CurriedSignatureList:  tt.currySig,
+					},
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+				},
+			})
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsQuickGeneratable(t *testing.T) {
+	tests := []struct {
+		t        domain.Type
+		expected bool
+	}{
+		{domain.TermType("int"), true},
+		{domain.TermType("*string"), true},
+		{domain.TermType("[]float64"), true},
+		{domain.TermType("[3]uint8"), true},
+		{domain.TermType("map[string][]int"), true},
+		{domain.TermType("map[[2]int]bool"), true},
+		{domain.TermType("io.Writer"), false},
+		{domain.TermType("[]io.Writer"), false},
+		{domain.TermType("map[string]io.Writer"), false},
+		{domain.TermType("func(int) int"), false},
+		{domain.TermType("chan int"), false},
+		{domain.NewFuncType(nil, nil), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmtType(tt.t), func(t *testing.T) {
+			actual := isQuickGeneratable(tt.t)
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func fmtType(t domain.Type) string {
+	if t.IsFuncType() {
+		return "FuncType"
+	}
+	return string(t.(domain.TermType))
+}
@@ -0,0 +1,143 @@
+package presenter
+
+import (
+	"io"
+	"unicode"
+
+	"golang.org/x/xerrors"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// Variant is a kind of variants of curried functions.
+type Variant int
+
+const (
+	// MustVariant panics if the original function returns an error (MustCurriedX).
+	MustVariant Variant = iota
+	// ResultVariant returns result.Result[T] of the original function returning (T, error) (ResultCurriedX).
+	ResultVariant
+	// AsyncVariant calls the original function in a new goroutine and returns future.Future[T] (AsyncCurriedX).
+	AsyncVariant
+)
+
+type curryFunctionVariantPresenter struct {
+	curryFunctionPresenter
+	variants []Variant
+}
+
+// NewCurryFunctionVariantPresenter creates a new CurryFunctionOutputPort,
+// which also writes variants of curried functions.
+// Variants are written only for functions whose returned types are supported by them.
+func NewCurryFunctionVariantPresenter(
+	writer io.Writer,
+	variants ...Variant,
+) usecase.CurryFunctionOutputPort {
+	return &curryFunctionVariantPresenter{
+		curryFunctionPresenter: curryFunctionPresenter{writer: writer},
+		variants:               variants,
+	}
+}
+
+// Show writes source code of curried functions and their variants to p.writer.
+func (p *curryFunctionVariantPresenter) Show(out *usecase.CurryFunctionOutputData) error {
+	if len(out.Functions) == 0 {
+		return xerrors.Errorf("Functions must not be empty")
+	}
+
+	f := jen.NewFilePathName(
+		outputPackagePathOf(out.CurriedFunctionMetaData),
+		outputPackageNameOf(out.CurriedFunctionMetaData),
+	)
+
+	addHeaderComments(f, out.CurriedFunctionMetaData)
+	importNames := registerImports(f, out, []*usecase.ImportMetaData{
+		{Path: resultPackagePath, Name: "result"},
+		{Path: futurePackagePath, Name: "future"},
+	})
+
+	code, err := p.code(out, importNames)
+	if err != nil {
+		return xerrors.Errorf("failed to generate code: %w", err)
+	}
+	f.Add(code)
+
+	for _, fn := range out.Functions {
+		orig := p.originalFuncOf(fn, out.CurriedFunctionMetaData)
+
+		// NOTE: results are declared as local variables in variants
+		extra := append(resultNames(fn.OriginalSignatureList.ReturnTypes()), "err",
+			importNames[resultPackagePath], importNames[futurePackagePath])
+		needed := neededIdentifiers(fn, out.CurriedFunctionMetaData, importNames, extra...)
+		currySig, origSig := renameShadowingParams(fn.CurriedSignatureList, fn.OriginalSignatureList, needed)
+
+		for _, v := range p.variants {
+			switch {
+			case v == MustVariant && returnsError(origSig):
+				f.Add(p.mustCode(currySig, origSig, orig))
+			case v == ResultVariant && returnsValueAndError(origSig):
+				f.Add(p.resultCode(currySig, origSig, orig))
+			case v == AsyncVariant && isAsyncable(origSig):
+				f.Add(p.asyncCode(currySig, origSig, orig))
+			}
+		}
+	}
+
+	if err := f.Render(p.writer); err != nil {
+		return xerrors.Errorf("failed to write code: %w", err)
+	}
+
+	return nil
+}
+
+// variantName returns the name of the variant of the curried function (e.g. MustCurriedX, mustCurriedX).
+func variantName(prefix string, curriedName string) string {
+	runes := []rune(curriedName)
+	if unicode.IsUpper(runes[0]) {
+		return prefix + curriedName
+	}
+
+	runes[0] = unicode.ToUpper(runes[0])
+	prefixRunes := []rune(prefix)
+	prefixRunes[0] = unicode.ToLower(prefixRunes[0])
+	return string(prefixRunes) + string(runes)
+}
+
+// withReturnTypes returns the curried signature list named name whose last stage returns returnTypes.
+func withReturnTypes(
+	currySig *domain.CurriedSignatureList,
+	name string,
+	returnTypes []domain.Type,
+) *domain.CurriedSignatureList {
+	partials := currySig.PartiallyAppliedSignatures
+	newPartials := make([]*domain.FunctionSignature, len(partials))
+
+	// NOTE: stages are rebuilt from inner to outer because each stage returns the next stage
+	returns := returnTypes
+	for i := len(partials) - 1; i >= 0; i-- {
+		newPartials[i] = domain.NewFunctionSignature(partials[i].Name(), partials[i].Parameters(), returns)
+		returns = []domain.Type{newPartials[i].Type()}
+	}
+
+	return domain.NewCurriedSignatureList(
+		domain.NewFunctionSignature(name, currySig.CurriedSignature.Parameters(), returns),
+		newPartials,
+	)
+}
+
+// variantCoreCode generates the inner most function with the body.
+func (p *curryFunctionVariantPresenter) variantCoreCode(
+	sig *domain.FunctionSignature,
+	body []jen.Code,
+) jen.Code {
+	fn := jen.Func().Params(renderParams(sig.Parameters())...)
+
+	if len(sig.ReturnTypes()) > 0 {
+		fn.Params(renderTypes(sig.ReturnTypes())...)
+	}
+
+	return fn.Block(body...)
+}
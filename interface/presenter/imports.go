@@ -0,0 +1,216 @@
+package presenter
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// registerImports decides names of imported packages in f and returns them.
+// Aliases in the source file are reused, and names conflicting with declarations
+// in generated code (or with each other) are renamed.
+// extra is packages imported only by generated code.
+// NOTE: parameters shadowing package names are renamed instead (see renameShadowingParams)
+func registerImports(
+	f *jen.File,
+	out *usecase.CurryFunctionOutputData,
+	extra []*usecase.ImportMetaData,
+) map[string]string {
+	imports := decideImports(out, extra)
+
+	names := map[string]string{}
+	for _, imp := range imports {
+		names[imp.path] = imp.name
+
+		if imp.aliased {
+			f.ImportAlias(imp.path, imp.name)
+		} else {
+			f.ImportName(imp.path, imp.name)
+		}
+	}
+
+	return names
+}
+
+// decidedImport is an import whose name in generated code is decided.
+type decidedImport struct {
+	path string
+	name string
+	// whether the import requires an alias
+	aliased bool
+}
+
+// decideImports decides names of imported packages in generated code (see registerImports).
+func decideImports(
+	out *usecase.CurryFunctionOutputData,
+	extra []*usecase.ImportMetaData,
+) []*decidedImport {
+	meta := out.CurriedFunctionMetaData
+
+	imports := map[string]*usecase.ImportMetaData{}
+	for _, imp := range append(meta.Imports, extra...) {
+		imports[imp.Path] = imp
+	}
+
+	paths := importPathsOf(out.Functions)
+	for _, imp := range extra {
+		paths[imp.Path] = true
+	}
+	if outputPackagePathOf(meta) != packagePathOf(meta) {
+		paths[packagePathOf(meta)] = true
+		imports[packagePathOf(meta)] = &usecase.ImportMetaData{
+			Path: packagePathOf(meta),
+			Name: meta.PackageName,
+		}
+	}
+
+	used := identifiersOf(out.Functions)
+	decided := []*decidedImport{}
+
+	for _, importPath := range sortedImportPaths(paths, imports) {
+		imp, ok := imports[importPath]
+		if !ok {
+			// NOTE: package name is unknown (e.g. the type is declared by an alias)
+			imp = &usecase.ImportMetaData{Path: importPath}
+		}
+
+		name := importNameOf(imp)
+		if used[name] {
+			name = uniqueName(name, used)
+		}
+		used[name] = true
+
+		decided = append(decided, &decidedImport{
+			path:    importPath,
+			name:    name,
+			aliased: !isPackageName(imp, name),
+		})
+	}
+
+	return decided
+}
+
+// isPackageName reports whether name can be used without an alias.
+func isPackageName(imp *usecase.ImportMetaData, name string) bool {
+	if imp.Name != "" {
+		return name == imp.Name
+	}
+	// NOTE: guessed package name is used only if it is obviously the same as the path
+	return name == path.Base(imp.Path)
+}
+
+// importNameOf returns the name used in the source file.
+func importNameOf(imp *usecase.ImportMetaData) string {
+	switch {
+	// NOTE: dot imports and blank imports are not used in generated code
+	case imp.Alias != "" && imp.Alias != "." && imp.Alias != "_":
+		return imp.Alias
+	case imp.Name != "":
+		return imp.Name
+	default:
+		return guessPackageName(imp.Path)
+	}
+}
+
+// sortedImportPaths sorts paths so that aliased imports are prior to the others.
+func sortedImportPaths(paths map[string]bool, imports map[string]*usecase.ImportMetaData) []string {
+	sorted := []string{}
+	for p := range paths {
+		sorted = append(sorted, p)
+	}
+
+	isAliased := func(p string) bool {
+		imp, ok := imports[p]
+		return ok && imp.Alias != "" && imp.Alias != "." && imp.Alias != "_"
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if isAliased(sorted[i]) != isAliased(sorted[j]) {
+			return isAliased(sorted[i])
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted
+}
+
+func uniqueName(name string, used map[string]bool) string {
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s%d", name, i)
+		if !used[candidate] {
+			return candidate
+		}
+	}
+}
+
+var packageVersionPattern = regexp.MustCompile(`^v[0-9]+$`)
+
+// guessPackageName guesses the package name from the import path
+// (e.g. "gopkg.in/yaml.v3" -> "yaml", "github.com/foo/go-bar/v2" -> "bar").
+func guessPackageName(importPath string) string {
+	elems := strings.Split(importPath, "/")
+	name := elems[len(elems)-1]
+	if packageVersionPattern.MatchString(name) && len(elems) > 1 {
+		name = elems[len(elems)-2]
+	}
+
+	if i := strings.Index(name, "."); i != -1 {
+		name = name[:i]
+	}
+	name = strings.TrimPrefix(name, "go-")
+
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '~' {
+			return -1
+		}
+		return r
+	}, name)
+}
+
+// importPathsOf returns import paths in types of the functions.
+func importPathsOf(functions []*usecase.CurriedFunctionOutputData) map[string]bool {
+	paths := map[string]bool{}
+
+	var collect func(t domain.Type)
+	collect = func(t domain.Type) {
+		if t.IsFuncType() {
+			ft := t.(domain.FuncType)
+			for _, pt := range ft.ParamTypes() {
+				collect(pt)
+			}
+			for _, rt := range ft.ReturnTypes() {
+				collect(rt)
+			}
+			return
+		}
+
+		for _, m := range qualifiedNamePattern.FindAllStringSubmatch(string(t.(domain.TermType)), -1) {
+			paths[m[1]] = true
+		}
+	}
+
+	for _, fn := range functions {
+		collect(fn.OriginalSignatureList.Type())
+	}
+
+	return paths
+}
+
+// identifiersOf returns package-level identifiers referred in generated code.
+func identifiersOf(functions []*usecase.CurriedFunctionOutputData) map[string]bool {
+	idents := map[string]bool{}
+
+	for _, fn := range functions {
+		idents[fn.OriginalSignatureList.Name()] = true
+		idents[fn.CurriedSignatureList.CurriedSignature.Name()] = true
+	}
+
+	return idents
+}
@@ -0,0 +1,223 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionPresenterShowImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		imports  []*usecase.ImportMetaData
+		params   []domain.Parameter
+		expected string
+	}{
+		{
+			"aliases in source",
+			[]*usecase.ImportMetaData{
+				{Path: "html/template", Name: "template", Alias: "htemplate"},
+				{Path: "text/template", Name: "template", Alias: "ttemplate"},
+			},
+			[]domain.Parameter{
+				domain.NewParameter("h", domain.TermType("*html/template.Template")),
+				domain.NewParameter("t", domain.TermType("*text/template.Template")),
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import (
+				htemplate "html/template"
+				ttemplate "text/template"
+			)
+
+			// CurriedF is the curried form of f.
+			func CurriedF(h *htemplate.Template) func(*ttemplate.Template) {
+				return func(t *ttemplate.Template) {
+					f(h, t)
+				}
+			}
+			`,
+		},
+		{
+			"package name differs from path",
+			[]*usecase.ImportMetaData{
+				{Path: "gopkg.in/yaml.v3", Name: "yaml"},
+			},
+			[]domain.Parameter{
+				domain.NewParameter("n", domain.TermType("*gopkg.in/yaml.v3.Node")),
+				domain.NewParameter("i", domain.TermType("int")),
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "gopkg.in/yaml.v3"
+
+			// CurriedF is the curried form of f.
+			func CurriedF(n *yaml.Node) func(int) {
+				return func(i int) {
+					f(n, i)
+				}
+			}
+			`,
+		},
+		{
+			"dot import",
+			[]*usecase.ImportMetaData{
+				{Path: "strings", Name: "strings", Alias: "."},
+			},
+			[]domain.Parameter{
+				domain.NewParameter("b", domain.TermType("*strings.Builder")),
+				domain.NewParameter("i", domain.TermType("int")),
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "strings"
+
+			// CurriedF is the curried form of f.
+			func CurriedF(b *strings.Builder) func(int) {
+				return func(i int) {
+					f(b, i)
+				}
+			}
+			`,
+		},
+		{
+			"parameter shadows import name",
+			[]*usecase.ImportMetaData{
+				{Path: "io", Name: "io"},
+			},
+			[]domain.Parameter{
+				domain.NewParameter("io", domain.TermType("int")),
+				domain.NewParameter("w", domain.TermType("io.Writer")),
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "io"
+
+			// CurriedF is the curried form of f.
+			func CurriedF(io1 int) func(io.Writer) {
+				return func(w io.Writer) {
+					f(io1, w)
+				}
+			}
+			`,
+		},
+		{
+			"import names conflict with each other",
+			[]*usecase.ImportMetaData{
+				{Path: "html/template", Name: "template"},
+				{Path: "text/template", Name: "template"},
+			},
+			[]domain.Parameter{
+				domain.NewParameter("h", domain.TermType("*html/template.Template")),
+				domain.NewParameter("t", domain.TermType("*text/template.Template")),
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import (
+				"html/template"
+				template1 "text/template"
+			)
+
+			// CurriedF is the curried form of f.
+			func CurriedF(h *template.Template) func(*template1.Template) {
+				return func(t *template1.Template) {
+					f(h, t)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionPresenter(&buf)
+
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					binaryFunctionOutputData("f", "CurriedF", tt.params[0], tt.params[1]),
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+					Imports:     tt.imports,
+				},
+			})
+
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
+
+func TestGuessPackageName(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"io", "io"},
+		{"net/http", "http"},
+		{"gopkg.in/yaml.v3", "yaml"},
+		{"github.com/foo/go-bar", "bar"},
+		{"github.com/foo/bar/v2", "bar"},
+		{"github.com/foo/bar-baz", "barbaz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			actual := guessPackageName(tt.path)
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+// binaryFunctionOutputData returns output data of a function with 2 parameters and no return values.
+func binaryFunctionOutputData(
+	name string,
+	curriedName string,
+	p0 domain.Parameter,
+	p1 domain.Parameter,
+) *usecase.CurriedFunctionOutputData {
+	return &usecase.CurriedFunctionOutputData{
+		OriginalSignatureList: domain.NewFunctionSignature(name, []domain.Parameter{p0, p1}, []domain.Type{}),
+		CurriedSignatureList: domain.NewCurriedSignatureList(
+			domain.NewFunctionSignature(
+				curriedName,
+				[]domain.Parameter{p0},
+				[]domain.Type{domain.NewFuncType([]domain.Type{p1.Type}, []domain.Type{})},
+			),
+			[]*domain.FunctionSignature{
+				domain.NewFunctionSignature(name+"1", []domain.Parameter{p1}, []domain.Type{}),
+			},
+		),
+	}
+}
@@ -0,0 +1,263 @@
+package presenter
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/syuparn/chapati/domain"
+)
+
+// jsonType is a structured node of a type.
+//
+//	{"kind": "map", "key": {"kind": "builtin", "name": "string"}, "elem": {"kind": "named", "package": "io", "name": "Writer"}}
+type jsonType struct {
+	// Kind is one of "builtin", "named", "pointer", "slice", "array", "map", "chan", "func", "struct", "interface"
+	Kind     string      `json:"kind"`
+	Package  string      `json:"package,omitempty"`
+	Name     string      `json:"name,omitempty"`
+	TypeArgs []*jsonType `json:"typeArgs,omitempty"`
+	// Len is the length of an array.
+	Len string `json:"len,omitempty"`
+	// Dir is the direction of a channel ("both", "send" or "recv").
+	Dir      string      `json:"dir,omitempty"`
+	Key      *jsonType   `json:"key,omitempty"`
+	Elem     *jsonType   `json:"elem,omitempty"`
+	Params   []*jsonType `json:"params,omitempty"`
+	Results  []*jsonType `json:"results,omitempty"`
+	Variadic bool        `json:"variadic,omitempty"`
+	// Text is the source of a struct or interface type.
+	Text string `json:"text,omitempty"`
+}
+
+func jsonTypesOf(types []domain.Type) ([]*jsonType, error) {
+	converted := []*jsonType{}
+	for _, t := range types {
+		c, err := jsonTypeOf(t)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, c)
+	}
+	return converted, nil
+}
+
+func jsonTypeOf(t domain.Type) (*jsonType, error) {
+	if t.IsFuncType() {
+		ft := t.(domain.FuncType)
+
+		params, err := jsonTypesOf(ft.ParamTypes())
+		if err != nil {
+			return nil, err
+		}
+
+		results, err := jsonTypesOf(ft.ReturnTypes())
+		if err != nil {
+			return nil, err
+		}
+
+		return &jsonType{Kind: "func", Params: params, Results: results}, nil
+	}
+
+	return parseJSONType(string(t.(domain.TermType)))
+}
+
+// qualifiedPlaceholderPrefix replaces qualified names so that the type can be parsed as Go code.
+const qualifiedPlaceholderPrefix = "_chapatiQualified"
+
+// parseJSONType parses a type string such as "map[string]io.Writer".
+func parseJSONType(s string) (*jsonType, error) {
+	qualified := [][]string{}
+	replaced := qualifiedNamePattern.ReplaceAllStringFunc(s, func(name string) string {
+		m := qualifiedNamePattern.FindStringSubmatch(name)
+		qualified = append(qualified, m[1:])
+		return fmt.Sprintf("%s%d", qualifiedPlaceholderPrefix, len(qualified)-1)
+	})
+
+	expr, err := parser.ParseExpr(replaced)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse type %s: %w", s, err)
+	}
+
+	c := &jsonTypeConverter{qualified: qualified}
+	return c.convert(expr)
+}
+
+type jsonTypeConverter struct {
+	// pairs of package path and name
+	qualified [][]string
+}
+
+func (c *jsonTypeConverter) convert(expr ast.Expr) (*jsonType, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return c.ident(e), nil
+	case *ast.ParenExpr:
+		return c.convert(e.X)
+	case *ast.StarExpr:
+		elem, err := c.convert(e.X)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonType{Kind: "pointer", Elem: elem}, nil
+	case *ast.ArrayType:
+		elem, err := c.convert(e.Elt)
+		if err != nil {
+			return nil, err
+		}
+		if e.Len == nil {
+			return &jsonType{Kind: "slice", Elem: elem}, nil
+		}
+		return &jsonType{Kind: "array", Len: c.text(e.Len), Elem: elem}, nil
+	case *ast.MapType:
+		key, err := c.convert(e.Key)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := c.convert(e.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonType{Kind: "map", Key: key, Elem: elem}, nil
+	case *ast.ChanType:
+		elem, err := c.convert(e.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonType{Kind: "chan", Dir: chanDirOf(e.Dir), Elem: elem}, nil
+	case *ast.FuncType:
+		return c.funcType(e)
+	case *ast.Ellipsis:
+		// NOTE: variadic parameters are represented as slices with the flag on func
+		elem, err := c.convert(e.Elt)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonType{Kind: "slice", Elem: elem}, nil
+	case *ast.IndexExpr:
+		return c.instance(e.X, []ast.Expr{e.Index})
+	case *ast.IndexListExpr:
+		return c.instance(e.X, e.Indices)
+	case *ast.StructType:
+		return &jsonType{Kind: "struct", Text: c.text(e)}, nil
+	case *ast.InterfaceType:
+		return &jsonType{Kind: "interface", Text: c.text(e)}, nil
+	default:
+		return nil, xerrors.Errorf("unsupported type %s", c.text(expr))
+	}
+}
+
+func (c *jsonTypeConverter) ident(e *ast.Ident) *jsonType {
+	var i int
+	if _, err := fmt.Sscanf(e.Name, qualifiedPlaceholderPrefix+"%d", &i); err == nil && i < len(c.qualified) {
+		return &jsonType{Kind: "named", Package: c.qualified[i][0], Name: c.qualified[i][1]}
+	}
+
+	if isPredeclared(e.Name) {
+		return &jsonType{Kind: "builtin", Name: e.Name}
+	}
+	return &jsonType{Kind: "named", Name: e.Name}
+}
+
+func (c *jsonTypeConverter) instance(x ast.Expr, indices []ast.Expr) (*jsonType, error) {
+	t, err := c.convert(x)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indices {
+		arg, err := c.convert(index)
+		if err != nil {
+			return nil, err
+		}
+		t.TypeArgs = append(t.TypeArgs, arg)
+	}
+
+	return t, nil
+}
+
+func (c *jsonTypeConverter) funcType(e *ast.FuncType) (*jsonType, error) {
+	t := &jsonType{Kind: "func", Params: []*jsonType{}, Results: []*jsonType{}}
+
+	convertFields := func(fields *ast.FieldList) ([]*jsonType, error) {
+		converted := []*jsonType{}
+		if fields == nil {
+			return converted, nil
+		}
+
+		for _, field := range fields.List {
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				t.Variadic = true
+			}
+
+			ft, err := c.convert(field.Type)
+			if err != nil {
+				return nil, err
+			}
+
+			// NOTE: named fields such as "a, b int" have multiple types
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				converted = append(converted, ft)
+			}
+		}
+		return converted, nil
+	}
+
+	params, err := convertFields(e.Params)
+	if err != nil {
+		return nil, err
+	}
+	results, err := convertFields(e.Results)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Params = params
+	t.Results = results
+	return t, nil
+}
+
+// text returns the source of expr with the original qualified names.
+func (c *jsonTypeConverter) text(expr ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, token.NewFileSet(), expr)
+
+	s := buf.String()
+	for i := len(c.qualified) - 1; i >= 0; i-- {
+		s = strings.ReplaceAll(s, fmt.Sprintf("%s%d", qualifiedPlaceholderPrefix, i),
+			c.qualified[i][0]+"."+c.qualified[i][1])
+	}
+	return s
+}
+
+func chanDirOf(dir ast.ChanDir) string {
+	switch dir {
+	case ast.SEND:
+		return "send"
+	case ast.RECV:
+		return "recv"
+	default:
+		return "both"
+	}
+}
+
+func isPredeclared(name string) bool {
+	switch name {
+	case "bool", "byte", "rune", "string", "error", "any", "comparable",
+		"int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"float32", "float64", "complex64", "complex128":
+		return true
+	}
+	return false
+}
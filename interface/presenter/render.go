@@ -1,7 +1,7 @@
 package presenter
 
 import (
-	"strings"
+	"regexp"
 
 	"github.com/dave/jennifer/jen"
 	"github.com/syuparn/chapati/domain"
@@ -15,7 +15,7 @@ func renderParam(p domain.Parameter) jen.Code {
 }
 
 func renderParams(params []domain.Parameter) []jen.Code {
-	rendered := make([]jen.Code, len(params))
+	rendered := make([]jen.Code, 0, len(params))
 	for _, p := range params {
 		rendered = append(rendered, renderParam(p))
 	}
@@ -28,7 +28,7 @@ func renderParamValue(p domain.Parameter) jen.Code {
 }
 
 func renderParamValues(params []domain.Parameter) []jen.Code {
-	rendered := make([]jen.Code, len(params))
+	rendered := make([]jen.Code, 0, len(params))
 	for _, p := range params {
 		rendered = append(rendered, renderParamValue(p))
 	}
@@ -37,7 +37,7 @@ func renderParamValues(params []domain.Parameter) []jen.Code {
 }
 
 func renderTypes(types []domain.Type) []jen.Code {
-	rendered := make([]jen.Code, len(types))
+	rendered := make([]jen.Code, 0, len(types))
 	for _, t := range types {
 		rendered = append(rendered, renderType(t))
 	}
@@ -53,12 +53,31 @@ func renderType(t domain.Type) jen.Code {
 }
 
 func renderTermType(t domain.Type) jen.Code {
-	modulePath, typeName := splitType(t.(domain.TermType))
-	if modulePath == "" {
-		return jen.Id(typeName)
+	whole := string(t.(domain.TermType))
+
+	// NOTE: qualified names can appear in any part of the type (e.g. map[string]io.Writer)
+	matches := qualifiedNamePattern.FindAllStringSubmatchIndex(whole, -1)
+	if len(matches) == 0 {
+		return jen.Id(whole)
+	}
+
+	code := jen.Empty()
+	last := 0
+	for _, m := range matches {
+		if m[0] > last {
+			code.Op(whole[last:m[0]])
+		}
+
+		modulePath, typeName := whole[m[2]:m[3]], whole[m[4]:m[5]]
+		code.Qual(modulePath, typeName)
+		last = m[1]
+	}
+
+	if last < len(whole) {
+		code.Op(whole[last:])
 	}
 
-	return jen.Qual(modulePath, typeName)
+	return code
 }
 
 func renderFuncType(t domain.Type) jen.Code {
@@ -77,15 +96,5 @@ func renderFuncType(t domain.Type) jen.Code {
 	return fn
 }
 
-func splitType(t domain.TermType) (modulePath, typeName string) {
-	whole := string(t)
-	iSep := strings.LastIndex(whole, ".")
-
-	if iSep == -1 {
-		modulePath, typeName = "", whole
-		return
-	}
-
-	modulePath, typeName = whole[:iSep], whole[iSep+1:]
-	return
-}
+// qualifiedNamePattern matches a qualified name like "github.com/dave/jennifer/jen.Code".
+var qualifiedNamePattern = regexp.MustCompile(`((?:\w[\w.\-~]*/)*\w[\w.\-~]*)\.([\pL_][\pL\pN_]*)`)
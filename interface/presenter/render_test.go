@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+
+	"github.com/syuparn/chapati/domain"
+)
+
+func TestRenderHelpersHaveNoNilEntries(t *testing.T) {
+	params := []domain.Parameter{
+		domain.NewParameter("a", domain.TermType("int")),
+		domain.NewParameter("b", domain.TermType("string")),
+	}
+	types := []domain.Type{
+		domain.TermType("int"),
+		domain.TermType("error"),
+	}
+
+	tests := []struct {
+		name     string
+		rendered []jen.Code
+		expected int
+	}{
+		{"renderParams", renderParams(params), len(params)},
+		{"renderParamValues", renderParamValues(params), len(params)},
+		{"renderTypes", renderTypes(types), len(types)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.rendered) != tt.expected {
+				t.Fatalf("wrong value: expected %d, got %d", tt.expected, len(tt.rendered))
+			}
+
+			for i, c := range tt.rendered {
+				if c == nil {
+					t.Errorf("wrong value: rendered[%d] must not be nil", i)
+				}
+			}
+		})
+	}
+}
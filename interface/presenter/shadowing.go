@@ -0,0 +1,146 @@
+package presenter
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+// identifierPattern matches an identifier in a type (e.g. "Point" in "map[string]Point").
+var identifierPattern = regexp.MustCompile(`[\pL_][\pL\pN_]*`)
+
+// typeKeywords are keywords which can appear in types.
+var typeKeywords = map[string]bool{
+	"func": true, "map": true, "chan": true, "struct": true, "interface": true,
+}
+
+// neededIdentifiers returns identifiers referred in bodies of curried functions,
+// which must not be shadowed by parameters.
+// importNames is names of imported packages decided in the file (guessed if not found).
+func neededIdentifiers(
+	fn *usecase.CurriedFunctionOutputData,
+	meta usecase.CurriedFunctionMetaData,
+	importNames map[string]string,
+	extra ...string,
+) map[string]bool {
+	needed := map[string]bool{fn.OriginalSignatureList.Name(): true}
+	for _, name := range extra {
+		needed[name] = true
+	}
+
+	imports := map[string]*usecase.ImportMetaData{}
+	for _, imp := range meta.Imports {
+		imports[imp.Path] = imp
+	}
+
+	var collect func(t domain.Type)
+	collect = func(t domain.Type) {
+		if t.IsFuncType() {
+			ft := t.(domain.FuncType)
+			for _, pt := range ft.ParamTypes() {
+				collect(pt)
+			}
+			for _, rt := range ft.ReturnTypes() {
+				collect(rt)
+			}
+			return
+		}
+
+		s := string(t.(domain.TermType))
+		for _, m := range qualifiedNamePattern.FindAllStringSubmatch(s, -1) {
+			for _, name := range packageNameCandidates(m[1], imports, importNames) {
+				needed[name] = true
+			}
+			// NOTE: types in the output package are not qualified
+			needed[m[2]] = true
+		}
+
+		// NOTE: unqualified names are types in the source package or predeclared types
+		for _, ident := range identifierPattern.FindAllString(qualifiedNamePattern.ReplaceAllString(s, ""), -1) {
+			if !typeKeywords[ident] {
+				needed[ident] = true
+			}
+		}
+	}
+	collect(fn.OriginalSignatureList.Type())
+
+	return needed
+}
+
+// packageNameCandidates returns names which can be used as a qualifier of the package.
+func packageNameCandidates(
+	importPath string,
+	imports map[string]*usecase.ImportMetaData,
+	importNames map[string]string,
+) []string {
+	if name, ok := importNames[importPath]; ok {
+		return []string{name}
+	}
+
+	// NOTE: jen guesses the name by the last element of the path (e.g. "yamlv3" for "gopkg.in/yaml.v3")
+	candidates := []string{guessPackageName(importPath), jenAliasOf(importPath)}
+	if imp, ok := imports[importPath]; ok {
+		candidates = append(candidates, importNameOf(imp))
+	}
+	return candidates
+}
+
+func jenAliasOf(importPath string) string {
+	name := strings.ToLower(importPath[strings.LastIndex(importPath, "/")+1:])
+	return strings.Map(func(r rune) rune {
+		if ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+// renameShadowingParams renames parameters which shadow needed identifiers.
+// Parameters are renamed consistently in all signatures, and their types are not changed.
+func renameShadowingParams(
+	currySig *domain.CurriedSignatureList,
+	origSig *domain.FunctionSignature,
+	needed map[string]bool,
+) (*domain.CurriedSignatureList, *domain.FunctionSignature) {
+	used := map[string]bool{}
+	for name := range needed {
+		used[name] = true
+	}
+	for _, param := range origSig.Parameters() {
+		used[param.Name] = true
+	}
+
+	renamed := map[string]string{}
+	for _, param := range origSig.Parameters() {
+		if needed[param.Name] {
+			newName := uniqueName(param.Name, used)
+			used[newName] = true
+			renamed[param.Name] = newName
+		}
+	}
+
+	if len(renamed) == 0 {
+		return currySig, origSig
+	}
+
+	partials := make([]*domain.FunctionSignature, len(currySig.PartiallyAppliedSignatures))
+	for i, sig := range currySig.PartiallyAppliedSignatures {
+		partials[i] = renameParams(sig, renamed)
+	}
+
+	return domain.NewCurriedSignatureList(renameParams(currySig.CurriedSignature, renamed), partials),
+		renameParams(origSig, renamed)
+}
+
+func renameParams(sig *domain.FunctionSignature, renamed map[string]string) *domain.FunctionSignature {
+	params := sig.Parameters()
+	for i, param := range params {
+		if newName, ok := renamed[param.Name]; ok {
+			params[i] = domain.NewParameter(newName, param.Type)
+		}
+	}
+
+	return domain.NewFunctionSignature(sig.Name(), params, sig.ReturnTypes())
+}
@@ -0,0 +1,142 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+
+	"github.com/syuparn/chapati/domain"
+	"github.com/syuparn/chapati/usecase"
+)
+
+func TestCurryFunctionPresenterShowShadowingParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		funcName string
+		imports  []*usecase.ImportMetaData
+		params   []domain.Parameter
+		expected string
+	}{
+		{
+			"parameter shadows the function",
+			"sum",
+			nil,
+			[]domain.Parameter{
+				domain.NewParameter("sum", domain.TermType("[]int")),
+				domain.NewParameter("n", domain.TermType("int")),
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedSum is the curried form of sum.
+			func CurriedSum(sum1 []int) func(int) {
+				return func(n int) {
+					sum(sum1, n)
+				}
+			}
+			`,
+		},
+		{
+			"parameter shadows a type",
+			"move",
+			nil,
+			[]domain.Parameter{
+				domain.NewParameter("int", domain.TermType("string")),
+				domain.NewParameter("p", domain.TermType("map[int]Point")),
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			// CurriedMove is the curried form of move.
+			func CurriedMove(int1 string) func(map[int]Point) {
+				return func(p map[int]Point) {
+					move(int1, p)
+				}
+			}
+			`,
+		},
+		{
+			"parameter shadows a guessed package name",
+			"decode",
+			nil,
+			[]domain.Parameter{
+				domain.NewParameter("yaml", domain.TermType("[]byte")),
+				domain.NewParameter("n", domain.TermType("*gopkg.in/yaml.v3.Node")),
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import yaml "gopkg.in/yaml.v3"
+
+			// CurriedDecode is the curried form of decode.
+			func CurriedDecode(yaml1 []byte) func(*yaml.Node) {
+				return func(n *yaml.Node) {
+					decode(yaml1, n)
+				}
+			}
+			`,
+		},
+		{
+			"renamed name is not used",
+			"write",
+			[]*usecase.ImportMetaData{
+				{Path: "io", Name: "io"},
+			},
+			[]domain.Parameter{
+				domain.NewParameter("io", domain.TermType("io.Writer")),
+				domain.NewParameter("io1", domain.TermType("int")),
+			},
+			`
+			// Code generated by chapati; DO NOT EDIT.
+
+			package mypackage
+
+			import "io"
+
+			// CurriedWrite is the curried form of write.
+			func CurriedWrite(io2 io.Writer) func(int) {
+				return func(io1 int) {
+					write(io2, io1)
+				}
+			}
+			`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := NewCurryFunctionPresenter(&buf)
+
+			curriedName := "Curried" + strings.Title(tt.funcName)
+			err := p.Show(&usecase.CurryFunctionOutputData{
+				Functions: []*usecase.CurriedFunctionOutputData{
+					binaryFunctionOutputData(tt.funcName, curriedName, tt.params[0], tt.params[1]),
+				},
+				CurriedFunctionMetaData: usecase.CurriedFunctionMetaData{
+					PackageName: "mypackage",
+					Imports:     tt.imports,
+				},
+			})
+
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			actual := buf.String()
+			expected := strings.TrimPrefix(dedent.Dedent(tt.expected), "\n")
+
+			if actual != expected {
+				t.Errorf("wrong value: expected ```\n%s\n```, got ```\n%s\n```", expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Package memo provides a concurrency-safe cache used by memoized curried functions
+// (generated by chapati gen -memoize).
+//
+//	var curriedMatchMemo = memo.New(128)
+//
+//	func CurriedMatch(pattern string) func(string) bool {
+//		return curriedMatchMemo.Get(pattern, func() interface{} {
+//			return func(s string) bool {
+//				return Match(pattern, s)
+//			}
+//		}).(func(string) bool)
+//	}
+package memo
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Cache holds values by comparable keys.
+// If the number of entries exceeds the size, the least recently used entry is evicted.
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[interface{}]*list.Element
+	// NOTE: the front is the most recently used entry
+	order *list.List
+}
+
+type entry struct {
+	key   interface{}
+	value interface{}
+}
+
+// New creates a new Cache holding at most size entries (size must be positive).
+func New(size int) *Cache {
+	if size <= 0 {
+		panic("memo: size must be positive")
+	}
+
+	return &Cache{
+		size:    size,
+		entries: map[interface{}]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+// Get returns the value of key.
+// If key is not found, f is called and its result is stored
+// (f is called only once for the same key unless the entry is evicted).
+// Get panics if the dynamic type of key is not comparable.
+func (c *Cache) Get(key interface{}, f func() interface{}) interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*entry).value
+	}
+
+	// NOTE: f is called in the lock so that the same value is returned for the same key
+	value := f()
+	c.entries[key] = c.order.PushFront(&entry{key: key, value: value})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).key)
+	}
+
+	return value
+}
+
+// Len returns the number of entries.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
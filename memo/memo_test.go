@@ -0,0 +1,119 @@
+package memo
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestCacheGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		keys     []interface{}
+		expected []interface{}
+		calls    int
+	}{
+		{
+			"same key",
+			2,
+			[]interface{}{"a", "a", "a"},
+			[]interface{}{"a1", "a1", "a1"},
+			1,
+		},
+		{
+			"different keys",
+			2,
+			[]interface{}{"a", "b", "a", "b"},
+			[]interface{}{"a1", "b2", "a1", "b2"},
+			2,
+		},
+		{
+			"least recently used entry is evicted",
+			2,
+			[]interface{}{"a", "b", "a", "c", "a", "b"},
+			[]interface{}{"a1", "b2", "a1", "c3", "a1", "b4"},
+			4,
+		},
+		{
+			"keys of different types",
+			3,
+			[]interface{}{1, "1", int64(1), 1},
+			[]interface{}{"11", "12", "13", "11"},
+			3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(tt.size)
+
+			calls := 0
+			actual := []interface{}{}
+			for _, key := range tt.keys {
+				key := key
+				v := c.Get(key, func() interface{} {
+					calls++
+					return fmt.Sprintf("%v%d", key, calls)
+				})
+				actual = append(actual, v)
+			}
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("wrong value: expected %v, got %v", tt.expected, actual)
+			}
+
+			if calls != tt.calls {
+				t.Errorf("wrong calls: expected %d, got %d", tt.calls, calls)
+			}
+
+			if c.Len() > tt.size {
+				t.Errorf("cache must not exceed the size %d (got %d)", tt.size, c.Len())
+			}
+		})
+	}
+}
+
+func TestCacheGetConcurrently(t *testing.T) {
+	c := New(1)
+
+	var mu sync.Mutex
+	calls := 0
+
+	var wg sync.WaitGroup
+	values := make([]interface{}, 100)
+	for i := range values {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			values[i] = c.Get("key", func() interface{} {
+				mu.Lock()
+				defer mu.Unlock()
+				calls++
+				return &calls
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("f must be called once (got %d)", calls)
+	}
+
+	for _, v := range values {
+		if v != values[0] {
+			t.Fatalf("the same value must be returned: expected %p, got %p", values[0], v)
+		}
+	}
+}
+
+func TestNewPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("New must panic if size is not positive")
+		}
+	}()
+
+	New(0)
+}
@@ -0,0 +1,38 @@
+// Package testfuncs has functions to cross-check reflectcurry with generated code.
+package testfuncs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//go:generate go run ../../../cmd/chapati gen funcs.go
+
+// Add returns the sum of a and b.
+func Add(a, b int) int {
+	return a + b
+}
+
+// Describe formats values of different types.
+func Describe(name string, age int, tags []string) string {
+	return fmt.Sprintf("%s(%d) %v", name, age, tags)
+}
+
+// Join joins elems with sep.
+func Join(sep string, elems ...string) string {
+	return strings.Join(elems, sep)
+}
+
+// Div returns the quotient and the remainder.
+func Div(a, b int) (int, int, error) {
+	if b == 0 {
+		return 0, 0, errors.New("division by zero")
+	}
+	return a / b, a % b, nil
+}
+
+// Record appends s to log.
+func Record(log *[]string, s string) {
+	*log = append(*log, s)
+}
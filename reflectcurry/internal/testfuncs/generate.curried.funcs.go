@@ -0,0 +1,50 @@
+// Code generated by chapati; DO NOT EDIT.
+
+package testfuncs
+
+// CurriedAdd is the curried form of Add.
+//
+// Add returns the sum of a and b.
+func CurriedAdd(a int) func(int) int {
+	return func(b int) int {
+		return Add(a, b)
+	}
+}
+
+// CurriedDescribe is the curried form of Describe.
+//
+// Describe formats values of different types.
+func CurriedDescribe(name string) func(int) func([]string) string {
+	return func(age int) func([]string) string {
+		return func(tags []string) string {
+			return Describe(name, age, tags)
+		}
+	}
+}
+
+// CurriedJoin is the curried form of Join.
+//
+// Join joins elems with sep.
+func CurriedJoin(sep string) func([]string) string {
+	return func(elems []string) string {
+		return Join(sep, elems...)
+	}
+}
+
+// CurriedDiv is the curried form of Div.
+//
+// Div returns the quotient and the remainder.
+func CurriedDiv(a int) func(int) (int, int, error) {
+	return func(b int) (int, int, error) {
+		return Div(a, b)
+	}
+}
+
+// CurriedRecord is the curried form of Record.
+//
+// Record appends s to log.
+func CurriedRecord(log *[]string) func(string) {
+	return func(s string) {
+		Record(log, s)
+	}
+}
@@ -0,0 +1,112 @@
+// Package reflectcurry curries functions only known at runtime by reflection.
+//
+// Curried functions have the same semantics as code generated by chapati:
+// functions with arity <= 1 cannot be curried, the last variadic parameter is received as a slice,
+// and the last stage returns all results of the original function.
+package reflectcurry
+
+import (
+	"reflect"
+
+	"golang.org/x/xerrors"
+)
+
+// Curry converts fn into the curried form.
+// For example, func(int, string) (bool, error) is converted into func(int) func(string) (bool, error).
+func Curry(fn interface{}) (interface{}, error) {
+	fv := reflect.ValueOf(fn)
+	if fv.Kind() != reflect.Func {
+		return nil, xerrors.Errorf("fn must be a function (got %T)", fn)
+	}
+	if fv.IsNil() {
+		return nil, xerrors.Errorf("fn must not be nil")
+	}
+
+	t := fv.Type()
+	if t.NumIn() <= 1 {
+		return nil, xerrors.Errorf("no need to curry fn (arity=%d)", t.NumIn())
+	}
+
+	return curry(fv, stageTypesOf(t), nil).Interface(), nil
+}
+
+// stageTypesOf returns types of the curried function and partially applied functions in order.
+func stageTypesOf(t reflect.Type) []reflect.Type {
+	outs := make([]reflect.Type, t.NumOut())
+	for i := range outs {
+		outs[i] = t.Out(i)
+	}
+
+	// NOTE: variadic parameter is received as a slice (t.In returns the slice type)
+	types := make([]reflect.Type, t.NumIn())
+	for i := t.NumIn() - 1; i >= 0; i-- {
+		types[i] = reflect.FuncOf([]reflect.Type{t.In(i)}, outs, false)
+		outs = []reflect.Type{types[i]}
+	}
+
+	return types
+}
+
+// curry makes the stage which receives the next argument after args.
+func curry(fv reflect.Value, types []reflect.Type, args []reflect.Value) reflect.Value {
+	return reflect.MakeFunc(types[len(args)], func(in []reflect.Value) []reflect.Value {
+		// NOTE: copy args so that the stage can be called more than once
+		applied := make([]reflect.Value, len(args)+1)
+		copy(applied, args)
+		applied[len(args)] = in[0]
+
+		if len(applied) < len(types) {
+			return []reflect.Value{curry(fv, types, applied)}
+		}
+
+		if fv.Type().IsVariadic() {
+			return fv.CallSlice(applied)
+		}
+		return fv.Call(applied)
+	})
+}
+
+// Apply calls curried with args one by one, and returns results of the last call.
+// If args are fewer than parameters, the partially applied function is returned.
+// nil args are converted into zero values of parameter types.
+func Apply(curried interface{}, args ...interface{}) ([]interface{}, error) {
+	results := []reflect.Value{reflect.ValueOf(curried)}
+
+	for i, arg := range args {
+		if len(results) != 1 || results[0].Kind() != reflect.Func || results[0].IsNil() {
+			return nil, xerrors.Errorf("too many arguments (%d functions applied, got %d args)", i, len(args))
+		}
+
+		fv := results[0]
+		if fv.Type().NumIn() != 1 {
+			return nil, xerrors.Errorf("function to be applied must have one parameter (got %s)", fv.Type())
+		}
+
+		v, err := argValue(arg, fv.Type().In(0))
+		if err != nil {
+			return nil, xerrors.Errorf("invalid args[%d]: %w", i, err)
+		}
+
+		results = fv.Call([]reflect.Value{v})
+	}
+
+	values := make([]interface{}, len(results))
+	for i, r := range results {
+		values[i] = r.Interface()
+	}
+
+	return values, nil
+}
+
+func argValue(arg interface{}, t reflect.Type) (reflect.Value, error) {
+	if arg == nil {
+		return reflect.Zero(t), nil
+	}
+
+	v := reflect.ValueOf(arg)
+	if !v.Type().AssignableTo(t) {
+		return reflect.Value{}, xerrors.Errorf("%s cannot be used as %s", v.Type(), t)
+	}
+
+	return v, nil
+}
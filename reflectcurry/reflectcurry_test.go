@@ -0,0 +1,238 @@
+package reflectcurry
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/syuparn/chapati"
+	"github.com/syuparn/chapati/reflectcurry/internal/testfuncs"
+)
+
+// NOTE: curried functions are cross-checked with code generated by chapati
+func TestCurryMatchesGeneratedCode(t *testing.T) {
+	var log1, log2 []string
+
+	tests := []struct {
+		name      string
+		fn        interface{}
+		generated interface{}
+		args      []interface{}
+	}{
+		{
+			"arity 2",
+			testfuncs.Add,
+			testfuncs.CurriedAdd,
+			[]interface{}{1, 2},
+		},
+		{
+			"arity 3",
+			testfuncs.Describe,
+			testfuncs.CurriedDescribe,
+			[]interface{}{"gopher", 13, []string{"go", "curry"}},
+		},
+		{
+			"variadic",
+			testfuncs.Join,
+			testfuncs.CurriedJoin,
+			[]interface{}{"-", []string{"a", "b", "c"}},
+		},
+		{
+			"multiple returns",
+			testfuncs.Div,
+			testfuncs.CurriedDiv,
+			[]interface{}{7, 2},
+		},
+		{
+			"multiple returns with error",
+			testfuncs.Div,
+			testfuncs.CurriedDiv,
+			[]interface{}{7, 0},
+		},
+		{
+			"nil argument",
+			testfuncs.Join,
+			testfuncs.CurriedJoin,
+			[]interface{}{",", nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			curried, err := Curry(tt.fn)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if reflect.TypeOf(curried) != reflect.TypeOf(tt.generated) {
+				t.Fatalf("wrong type: expected %T, got %T", tt.generated, curried)
+			}
+
+			actual, err := Apply(curried, tt.args...)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			expected, err := Apply(tt.generated, tt.args...)
+			if err != nil {
+				t.Fatalf("error must be nil: %v", err)
+			}
+
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("wrong value: expected %v, got %v", expected, actual)
+			}
+		})
+	}
+
+	t.Run("no return values", func(t *testing.T) {
+		curried, err := Curry(testfuncs.Record)
+		if err != nil {
+			t.Fatalf("error must be nil: %v", err)
+		}
+
+		curried.(func(*[]string) func(string))(&log1)("a")
+		testfuncs.CurriedRecord(&log2)("a")
+
+		if !reflect.DeepEqual(log1, log2) {
+			t.Errorf("wrong value: expected %v, got %v", log2, log1)
+		}
+	})
+}
+
+func TestCurryPartialApplication(t *testing.T) {
+	curried, err := Curry(func(a, b, c string) string { return a + b + c })
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	// NOTE: partially applied functions can be called more than once
+	ab := curried.(func(string) func(string) func(string) string)("a")("b")
+	if actual := ab("c") + ab("d"); actual != "abcabd" {
+		t.Errorf("wrong value: expected %v, got %v", "abcabd", actual)
+	}
+
+	results, err := Apply(curried, "x")
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+	if actual := results[0].(func(string) func(string) string)("y")("z"); actual != "xyz" {
+		t.Errorf("wrong value: expected %v, got %v", "xyz", actual)
+	}
+}
+
+func TestCurryFailed(t *testing.T) {
+	var nilFunc func(int, int) int
+
+	tests := []struct {
+		name     string
+		fn       interface{}
+		expected string
+	}{
+		{
+			"not a function",
+			1,
+			"fn must be a function (got int)",
+		},
+		{
+			"nil function",
+			nilFunc,
+			"fn must not be nil",
+		},
+		{
+			"arity 1",
+			strings.ToUpper,
+			"no need to curry fn (arity=1)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Curry(tt.fn)
+			if err == nil {
+				t.Fatalf("error must not be nil")
+			}
+
+			if err.Error() != tt.expected {
+				t.Errorf("wrong value: expected %q, got %q", tt.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestApplyFailed(t *testing.T) {
+	tests := []struct {
+		name     string
+		curried  interface{}
+		args     []interface{}
+		expected string
+	}{
+		{
+			"too many arguments",
+			testfuncs.CurriedAdd,
+			[]interface{}{1, 2, 3},
+			"too many arguments (2 functions applied, got 3 args)",
+		},
+		{
+			"wrong type",
+			testfuncs.CurriedAdd,
+			[]interface{}{"1"},
+			"invalid args[0]: string cannot be used as int",
+		},
+		{
+			"not curried",
+			testfuncs.Add,
+			[]interface{}{1},
+			"function to be applied must have one parameter (got func(int, int) int)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Apply(tt.curried, tt.args...)
+			if err == nil {
+				t.Fatalf("error must not be nil")
+			}
+
+			if err.Error() != tt.expected {
+				t.Errorf("wrong value: expected %q, got %q", tt.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestGeneratedCodeIsUpToDate(t *testing.T) {
+	const src = "internal/testfuncs/funcs.go"
+
+	expected, err := chapati.Generate(nil, src, chapati.Options{})
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	actual, err := os.ReadFile("internal/testfuncs/generate.curried.funcs.go")
+	if err != nil {
+		t.Fatalf("error must be nil: %v", err)
+	}
+
+	if !bytes.Equal(actual, expected) {
+		t.Errorf("generated code is out of date (run 'go generate ./reflectcurry/...')")
+	}
+}
+
+func ExampleCurry() {
+	curried, _ := Curry(func(a, b int) (int, error) {
+		if b == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return a / b, nil
+	})
+
+	fmt.Println(curried.(func(int) func(int) (int, error))(6)(3))
+	fmt.Println(Apply(curried, 6, 0))
+	// Output:
+	// 2 <nil>
+	// [0 division by zero] <nil>
+}
@@ -0,0 +1,66 @@
+// Package result provides Result, a value or an error, returned by curried functions
+// generated with chapati gen -result (Go 1.18 or later).
+//
+// Stages of curried functions can be chained by Bind, and the first error short-circuits the rest.
+//
+//	// ResultCurriedDiv(a)(b) returns Result[int] of a / b
+//	r := result.Bind(ResultCurriedDiv(100)(4), ResultCurriedDiv(1000))
+//	r.Get() // 40, nil
+package result
+
+// Result is a value or an error.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Of creates a new Result from returned values of a function.
+func Of[T any](value T, err error) Result[T] {
+	return Result[T]{value: value, err: err}
+}
+
+// Ok creates a new Result of the value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Fail creates a new Result of the error.
+func Fail[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Get returns the value and the error.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Err returns the error (nil if r has the value).
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Must returns the value, or panics if r has the error.
+func (r Result[T]) Must() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// Bind applies f to the value of r.
+// If r has the error, f is not called and the error is returned.
+func Bind[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Fail[U](r.err)
+	}
+	return f(r.value)
+}
+
+// Map applies f to the value of r.
+// If r has the error, f is not called and the error is returned.
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Fail[U](r.err)
+	}
+	return Ok(f(r.value))
+}
@@ -0,0 +1,107 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+var errZeroDivision = errors.New("zero division")
+
+func atoi(s string) Result[int] {
+	return Of(strconv.Atoi(s))
+}
+
+func div(a int) func(int) Result[int] {
+	return func(b int) Result[int] {
+		if b == 0 {
+			return Fail[int](errZeroDivision)
+		}
+		return Ok(a / b)
+	}
+}
+
+func TestBind(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   Result[int]
+		expected int
+		err      string
+	}{
+		{
+			"ok",
+			Bind(atoi("4"), div(100)),
+			25,
+			"",
+		},
+		{
+			"error in the first stage",
+			Bind(atoi("four"), div(100)),
+			0,
+			`strconv.Atoi: parsing "four": invalid syntax`,
+		},
+		{
+			"error in the second stage",
+			Bind(Bind(atoi("0"), div(100)), div(1)),
+			0,
+			"zero division",
+		},
+		{
+			"map",
+			Map(Bind(atoi("4"), div(100)), func(n int) int { return n * 2 }),
+			50,
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.actual.Get()
+
+			if fmt.Sprint(err) != fmt.Sprint(errOrNil(tt.err)) {
+				t.Fatalf("wrong error: expected %v, got %v", errOrNil(tt.err), err)
+			}
+
+			if actual != tt.expected {
+				t.Errorf("wrong value: expected %d, got %d", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBindShortCircuits(t *testing.T) {
+	called := false
+	r := Bind(Fail[int](errZeroDivision), func(n int) Result[string] {
+		called = true
+		return Ok(strconv.Itoa(n))
+	})
+
+	if called {
+		t.Errorf("f must not be called if the result has an error")
+	}
+
+	if !errors.Is(r.Err(), errZeroDivision) {
+		t.Errorf("wrong value: expected %v, got %v", errZeroDivision, r.Err())
+	}
+}
+
+func TestMust(t *testing.T) {
+	if actual := Ok(1).Must(); actual != 1 {
+		t.Errorf("wrong value: expected 1, got %d", actual)
+	}
+
+	defer func() {
+		if r := recover(); r != errZeroDivision {
+			t.Errorf("wrong value: expected panic %v, got %v", errZeroDivision, r)
+		}
+	}()
+	Fail[int](errZeroDivision).Must()
+}
+
+func errOrNil(msg string) error {
+	if msg == "" {
+		return nil
+	}
+	return errors.New(msg)
+}
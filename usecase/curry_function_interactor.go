@@ -11,30 +11,47 @@ type curryFunctionInteractor struct {
 }
 
 func (p curryFunctionInteractor) Exec(in *CurryFunctionInputData) error {
-	params := []domain.Parameter{}
-	for n, t := range in.Parameters {
-		param := domain.NewParameter(n, domain.TermType(t))
-		params = append(params, param)
-	}
+	functions := []*CurriedFunctionOutputData{}
 
-	returnTypes := make([]domain.Type, len(in.ReturnTypes))
-	for i, t := range in.ReturnTypes {
-		returnTypes[i] = domain.TermType(t)
-	}
+	for _, fn := range in.Functions {
+		funcSignature := p.signatureOf(fn)
+
+		// NOTE: functions which do not need currying are skipped
+		if funcSignature.Arity() <= 1 {
+			continue
+		}
 
-	funcSignature := domain.NewFunctionSignature(in.FuncName, params, returnTypes)
-	if funcSignature.Arity() <= 1 {
-		return xerrors.Errorf("no need to curry fn (arity=%d)", funcSignature.Arity())
+		// NOTE: original signature is kept to call the original function
+		curriedOrder := funcSignature
+		if len(fn.ArgumentOrder) > 0 {
+			reordered, err := funcSignature.Reorder(fn.ArgumentOrder)
+			if err != nil {
+				return xerrors.Errorf("failed to reorder arguments of %s: %w", fn.FuncName, err)
+			}
+			curriedOrder = reordered
+		}
+
+		curried, err := p.curryService.Curry(curriedOrder, fn.CurriedFuncName)
+		if err != nil {
+			return xerrors.Errorf("failed to curry %s: %w", fn.FuncName, err)
+		}
+
+		functions = append(functions, &CurriedFunctionOutputData{
+			OriginalSignatureList: funcSignature,
+			CurriedSignatureList:  curried,
+			Doc:                   fn.Doc,
+			Variadic:              fn.Variadic,
+			IncomparableParams:    incomparableParamsOf(fn),
+			CallArities:           fn.CallArities,
+		})
 	}
 
-	curried, err := p.curryService.Curry(funcSignature, in.CurriedFuncName)
-	if err != nil {
-		return xerrors.Errorf("failed to curry: %w", err)
+	if len(functions) == 0 {
+		return xerrors.Errorf("no functions need to be curried (all of them have arity <= 1)")
 	}
 
 	out := &CurryFunctionOutputData{
-		OriginalSignatureList:   funcSignature,
-		CurriedSignatureList:    curried,
+		Functions:               functions,
 		CurriedFunctionMetaData: in.CurriedFunctionMetaData,
 	}
 
@@ -45,6 +62,30 @@ func (p curryFunctionInteractor) Exec(in *CurryFunctionInputData) error {
 	return nil
 }
 
+func (p curryFunctionInteractor) signatureOf(fn *FunctionInputData) *domain.FunctionSignature {
+	params := make([]domain.Parameter, len(fn.Parameters))
+	for i, param := range fn.Parameters {
+		params[i] = domain.NewParameter(param.Name, domain.TermType(param.Type))
+	}
+
+	returnTypes := make([]domain.Type, len(fn.ReturnTypes))
+	for i, t := range fn.ReturnTypes {
+		returnTypes[i] = domain.TermType(t)
+	}
+
+	return domain.NewFunctionSignature(fn.FuncName, params, returnTypes)
+}
+
+func incomparableParamsOf(fn *FunctionInputData) []string {
+	var names []string
+	for _, param := range fn.Parameters {
+		if param.Incomparable {
+			names = append(names, param.Name)
+		}
+	}
+	return names
+}
+
 // NewCurryFunctionInputPort creates a new CurryFunctionInputPort.
 func NewCurryFunctionInputPort(
 	out CurryFunctionOutputPort,
@@ -11,11 +11,34 @@ type CurryFunctionInputPort interface {
 
 // CurryFunctionInputData is a DTO for CurryFunctionInputPort.
 type CurryFunctionInputData struct {
+	Functions []*FunctionInputData
+	CurriedFunctionMetaData
+}
+
+// FunctionInputData is a DTO of each function to be curried.
+type FunctionInputData struct {
 	FuncName        string
 	CurriedFuncName string
-	Parameters      map[string]string
+	Parameters      []*ParameterInputData
 	ReturnTypes     []string
-	CurriedFunctionMetaData
+	// ArgumentOrder is parameter names in the order of curried function (original order if empty).
+	ArgumentOrder []string
+	// Doc is the text of the doc comment of the function.
+	Doc string
+	// Variadic reports whether the last parameter is variadic (its type is the slice type).
+	Variadic bool
+	// CallArities are numbers of parameters passed to each call of the function and returned functions
+	// in deep currying (e.g. [1, 2] for Make(a)(b, c)).
+	// Parameters and ReturnTypes are flattened through the calls. nil means one call.
+	CallArities []int
+}
+
+// ParameterInputData is a DTO of each parameter of a function.
+type ParameterInputData struct {
+	Name string
+	Type string
+	// Incomparable reports whether values of the type cannot be compared (e.g. slices, maps and functions).
+	Incomparable bool
 }
 
 // CurryFunctionOutputPort presents the result of currying function.
@@ -25,13 +48,45 @@ type CurryFunctionOutputPort interface {
 
 // CurryFunctionOutputData is a DTO for CurryFunctionOutputPort.
 type CurryFunctionOutputData struct {
+	Functions []*CurriedFunctionOutputData
+	CurriedFunctionMetaData
+}
+
+// CurriedFunctionOutputData is a DTO of each curried function.
+type CurriedFunctionOutputData struct {
 	OriginalSignatureList *domain.FunctionSignature
 	CurriedSignatureList  *domain.CurriedSignatureList
-	CurriedFunctionMetaData
+	// Doc is the text of the doc comment of the original function.
+	Doc string
+	// Variadic reports whether the last parameter of the original function is variadic.
+	Variadic bool
+	// IncomparableParams are names of parameters whose values cannot be compared.
+	IncomparableParams []string
+	// CallArities are numbers of arguments passed to each call of the original function
+	// and returned functions (nil if all arguments are passed to the original function).
+	CallArities []int
 }
 
 // CurriedFunctionMetaData is a DTO to render source code.
 type CurriedFunctionMetaData struct {
 	PackageName string
-	//ImportNames
+	// PackagePath is the import path of the package (PackageName is used if empty).
+	PackagePath string
+	// OutputPackageName is the package of generated code (PackageName is used if empty).
+	OutputPackageName string
+	// OutputPackagePath is the import path of generated code (PackagePath is used if empty).
+	OutputPackagePath string
+	// BuildConstraints are "//go:build" and "// +build" lines of the source file.
+	BuildConstraints []string
+	// Imports are packages imported by the source file.
+	Imports []*ImportMetaData
+}
+
+// ImportMetaData is a DTO of each imported package.
+type ImportMetaData struct {
+	Path string
+	// Name is the package name.
+	Name string
+	// Alias is the import name in the source file (empty if not specified).
+	Alias string
 }